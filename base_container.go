@@ -1,93 +1,896 @@
 package goinject
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"reflect"
+	"slices"
+	"strings"
 	"sync"
 )
 
-type abstractType reflect.Type
-type concreteType reflect.Type
+// bindingKey identifies a relation by its abstract type and name. The empty
+// name identifies the default relation of the abstract type.
+type bindingKey struct {
+	abstractType reflect.Type
+	name         string
+}
+
+func (k bindingKey) String() string {
+	if k.name == "" {
+		return typeName(k.abstractType)
+	}
+
+	return fmt.Sprintf("%s %q", typeName(k.abstractType), k.name)
+}
+
+// binding holds how the concrete instance of an abstract type is built.
+type binding struct {
+	owner        *BaseContainer
+	key          bindingKey
+	lifetime     Lifetime
+	multi        bool
+	eager        bool
+	module       string
+	site         string
+	concreteType reflect.Type
+	fields       []injectField
+	factory      func() (any, error)
+	constructor  reflect.Value
+}
 
 type BaseContainer struct {
-	relations map[abstractType]concreteType
-	instances map[abstractType]any
+	parent    DIContainer
+	enclosing *BaseContainer
+
+	relations map[bindingKey]*binding
+	multi     map[reflect.Type][]*binding
+	bindings  []*binding
+	instances map[*binding]any
+	building  map[*binding]*buildCall
+	created   []*binding
+	closed    bool
+	strict    bool
 
 	mx sync.Mutex
 }
 
-func NewBaseContainer() *BaseContainer {
-	return &BaseContainer{
-		relations: make(map[abstractType]concreteType),
-		instances: make(map[abstractType]any),
+func NewBaseContainer(opts ...ContainerOption) *BaseContainer {
+	i := &BaseContainer{
+		relations: make(map[bindingKey]*binding),
+		multi:     make(map[reflect.Type][]*binding),
+		instances: make(map[*binding]any),
+		building:  make(map[*binding]*buildCall),
 	}
+
+	for _, opt := range opts {
+		opt(i)
+	}
+
+	return i
 }
 
-func (i *BaseContainer) Register(abstractType reflect.Type, concreteInstance any) {
-	i.RegisterType(abstractType, reflect.TypeOf(concreteInstance))
+func (i *BaseContainer) Register(abstractType reflect.Type, concreteInstance any, opts ...RegistrationOption) {
+	must(i.TryRegister(abstractType, concreteInstance, opts...))
+}
+
+func (i *BaseContainer) TryRegister(abstractType reflect.Type, concreteInstance any, opts ...RegistrationOption) error {
+	concreteType := reflect.TypeOf(concreteInstance)
+
+	if err := checkInstanceRelation(abstractType, concreteType); err != nil {
+		return err
+	}
+
+	r := newRegistration(opts)
+
+	if r.lifetime != Singleton {
+		return fmt.Errorf("%w: %s (lifetime), %s (concrete instance)", ErrLifetimeNotSupported, r.lifetime, typeName(concreteType))
+	}
+
+	b := &binding{concreteType: concreteType}
 
 	i.mx.Lock()
 	defer i.mx.Unlock()
 
-	i.instances[abstractType] = concreteInstance
+	if err := i.addRelation(abstractType, b, r); err != nil {
+		return err
+	}
+
+	i.instances[b] = concreteInstance
+
+	return nil
 }
 
-func (i *BaseContainer) RegisterType(abstractType reflect.Type, concreteType reflect.Type) {
-	if abstractType == nil || abstractType.Kind() != reflect.Interface {
-		panic(ErrNotAnInterface)
+func (i *BaseContainer) RegisterType(abstractType reflect.Type, concreteType reflect.Type, opts ...RegistrationOption) {
+	must(i.TryRegisterType(abstractType, concreteType, opts...))
+}
+
+func (i *BaseContainer) TryRegisterType(abstractType reflect.Type, concreteType reflect.Type, opts ...RegistrationOption) error {
+	if err := checkRelation(abstractType, concreteType); err != nil {
+		return err
 	}
 
-	if concreteType == nil {
-		panic(ErrNotAnStruct)
+	if concreteType.Kind() == reflect.Pointer {
+		concreteType = concreteType.Elem()
+	}
+
+	fields, err := injectFields(concreteType, !i.strict)
+	if err != nil {
+		return err
 	}
 
-	isConcreteStruct := concreteType.Kind() == reflect.Struct
-	isConcreteStructPtr := concreteType.Kind() == reflect.Pointer && concreteType.Elem().Kind() == reflect.Struct
+	i.mx.Lock()
+	defer i.mx.Unlock()
 
-	if !isConcreteStruct && !isConcreteStructPtr {
-		panic(ErrNotAnStruct)
+	return i.addRelation(abstractType, &binding{concreteType: concreteType, fields: fields}, newRegistration(opts))
+}
+
+func (i *BaseContainer) RegisterFactory(abstractType reflect.Type, factory func() (any, error), opts ...RegistrationOption) {
+	must(i.TryRegisterFactory(abstractType, factory, opts...))
+}
+
+func (i *BaseContainer) TryRegisterFactory(abstractType reflect.Type, factory func() (any, error), opts ...RegistrationOption) error {
+	if abstractType == nil || abstractType.Kind() != reflect.Interface {
+		return ErrNotAnInterface
 	}
 
-	if !concreteType.Implements(abstractType) {
-		panic(fmt.Errorf("%w: %s (concrete type), %s (abstract type)", ErrInterfaceNotImplemented, concreteType.Name(), abstractType.Name()))
+	if factory == nil {
+		return ErrNilFactory
 	}
 
 	i.mx.Lock()
 	defer i.mx.Unlock()
 
-	if _, ok := i.relations[abstractType]; ok {
-		panic(fmt.Errorf("%w: %s (abstract type)", ErrAlreadyRegistered, abstractType.Name()))
-	}
+	return i.addRelation(abstractType, &binding{factory: factory}, newRegistration(opts))
+}
 
-	if concreteType.Kind() == reflect.Pointer {
-		concreteType = concreteType.Elem()
+func (i *BaseContainer) RegisterConstructor(constructor any, opts ...RegistrationOption) {
+	must(i.TryRegisterConstructor(constructor, opts...))
+}
+
+func (i *BaseContainer) TryRegisterConstructor(constructor any, opts ...RegistrationOption) error {
+	fn := reflect.ValueOf(constructor)
+
+	abstractType, err := checkConstructor(fn, !i.strict)
+	if err != nil {
+		return err
 	}
 
-	i.relations[abstractType] = concreteType
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	return i.addRelation(abstractType, &binding{constructor: fn}, newRegistration(opts))
 }
 
 func (i *BaseContainer) Inject(abstractType reflect.Type) any {
+	instance, err := i.TryInject(abstractType)
+	must(err)
+
+	return instance
+}
+
+func (i *BaseContainer) TryInject(abstractType reflect.Type) (any, error) {
+	return i.TryInjectNamed(abstractType, "")
+}
+
+func (i *BaseContainer) InjectNamed(abstractType reflect.Type, name string) any {
+	instance, err := i.TryInjectNamed(abstractType, name)
+	must(err)
+
+	return instance
+}
+
+func (i *BaseContainer) TryInjectNamed(abstractType reflect.Type, name string) (any, error) {
+	return i.InjectNamedContext(context.Background(), abstractType, name)
+}
+
+func (i *BaseContainer) InjectContext(ctx context.Context, abstractType reflect.Type) (any, error) {
+	return i.InjectNamedContext(ctx, abstractType, "")
+}
+
+func (i *BaseContainer) InjectNamedContext(ctx context.Context, abstractType reflect.Type, name string) (any, error) {
+	return i.resolve(ctx, bindingKey{abstractType, name})
+}
+
+func (i *BaseContainer) HasRelation(abstractType reflect.Type, name string) bool {
+	return i.hasRelation(bindingKey{abstractType, name})
+}
+
+func (i *BaseContainer) InjectAll(abstractType reflect.Type) []any {
+	instances, err := i.TryInjectAll(abstractType)
+	must(err)
+
+	return instances
+}
+
+func (i *BaseContainer) TryInjectAll(abstractType reflect.Type) ([]any, error) {
+	return i.injectAll(context.Background(), abstractType)
+}
+
+// injectAll the instances of the multi-bindings of the abstract type, like
+// [BaseContainer.TryInjectAll], with the context of the build injecting them.
+func (i *BaseContainer) injectAll(ctx context.Context, abstractType reflect.Type) ([]any, error) {
 	if abstractType == nil || abstractType.Kind() != reflect.Interface {
-		panic(ErrNotAnInterface)
+		return nil, ErrNotAnInterface
 	}
 
 	i.mx.Lock()
-	defer i.mx.Unlock()
 
-	concreteType, ok := i.relations[abstractType]
-	if !ok {
-		panic(fmt.Errorf("%w: %s (abstract type)", ErrNoConcreteTypeSupplied, abstractType.Name()))
+	if i.closed {
+		i.mx.Unlock()
+		return nil, ErrContainerClosed
+	}
+
+	bindings := i.lookupAll(abstractType)
+
+	i.mx.Unlock()
+
+	inherited, parent, err := i.lookupAllInherited(abstractType)
+	if err != nil {
+		return nil, err
 	}
 
-	instance, ok := i.instances[abstractType]
-	if !ok {
-		instance = reflect.New(concreteType).Interface()
-		i.instances[abstractType] = instance
+	bindings = append(inherited, bindings...)
+	instances := []any{}
 
-		if dInstance, ok := instance.(InitializableDependency); ok {
-			dInstance.InitializeDependency()
+	if parent != nil {
+		parentInstances, err := parent.TryInjectAll(abstractType)
+		if err != nil {
+			return nil, err
 		}
+
+		instances = append(instances, parentInstances...)
 	}
 
-	return instance
+	for _, b := range bindings {
+		instance, err := i.instance(ctx, b)
+		if err != nil {
+			return nil, err
+		}
+
+		instances = append(instances, instance)
+	}
+
+	return instances, nil
+}
+
+func (i *BaseContainer) Close() error {
+	i.mx.Lock()
+
+	if i.closed {
+		i.mx.Unlock()
+		return nil
+	}
+
+	i.closed = true
+	created := i.created
+	instances := make([]any, len(created))
+
+	for n, b := range created {
+		instances[n] = i.instances[b]
+	}
+
+	i.created = nil
+
+	i.mx.Unlock()
+
+	return disposeAll(created, instances)
+}
+
+// resolve the instance of the relation, building it if needed.
+//
+// Non-interface abstract types are only resolved as self-bindings, failing
+// with [ErrNotAnInterface] if they can't be registered as such, like for
+// strict containers, or with [ErrNoConcreteTypeSupplied] wrapping it if they
+// aren't registered.
+func (i *BaseContainer) resolve(ctx context.Context, key bindingKey) (any, error) {
+	isInterface := key.abstractType != nil && key.abstractType.Kind() == reflect.Interface
+
+	if !isInterface && !i.isSelfType(key.abstractType) {
+		return nil, ErrNotAnInterface
+	}
+
+	i.mx.Lock()
+
+	if i.closed {
+		i.mx.Unlock()
+		return nil, ErrContainerClosed
+	}
+
+	b := i.lookup(key)
+
+	i.mx.Unlock()
+
+	if b == nil {
+		inherited, parent, err := i.lookupInherited(key)
+		if err != nil {
+			return nil, err
+		}
+
+		if inherited != nil {
+			return i.instance(ctx, inherited)
+		}
+
+		if parent != nil {
+			return parent.InjectNamedContext(ctx, key.abstractType, key.name)
+		}
+
+		if !isInterface {
+			return nil, fmt.Errorf("%w: %s (abstract type), without a self-binding: %w", ErrNoConcreteTypeSupplied, key, ErrNotAnInterface)
+		}
+
+		return nil, fmt.Errorf("%w: %s (abstract type)", ErrNoConcreteTypeSupplied, key)
+	}
+
+	return i.instance(ctx, b)
+}
+
+// instance of the binding, building it if needed.
+//
+// [Transient] bindings are built on every call, and [Scoped] ones are cached
+// by the container resolving them. [Singleton] bindings are cached by the
+// container owning them, built with its own relations.
+//
+// No lock is held while building, so the instance can inject other ones while
+// being built, like from its InitializeDependency method. Concurrent calls for
+// the same binding wait for the first one to build it, instead of building it
+// twice. Calls made with the context of a build it depends on, or through the
+// containers and handles injected into it, fail with [ErrCircularDependency]
+// instead, since they'd wait forever, even from other goroutines.
+func (i *BaseContainer) instance(ctx context.Context, b *binding) (any, error) {
+	if b.lifetime == Singleton && b.owner != i {
+		return b.owner.instance(ctx, b)
+	}
+
+	if path := cycle(buildingCall(ctx).chain(), b); path != nil {
+		return nil, circularDependency(path)
+	}
+
+	if b.lifetime == Transient {
+		call := newBuildCall(ctx, b)
+		defer close(call.done)
+
+		return i.build(withBuilding(ctx, call), b)
+	}
+
+	i.mx.Lock()
+
+	if i.closed {
+		i.mx.Unlock()
+		return nil, ErrContainerClosed
+	}
+
+	if instance, ok := i.instances[b]; ok {
+		i.mx.Unlock()
+		return instance, nil
+	}
+
+	if call, ok := i.building[b]; ok {
+		i.mx.Unlock()
+		return wait(ctx, call)
+	}
+
+	call := newBuildCall(ctx, b)
+	i.building[b] = call
+
+	i.mx.Unlock()
+
+	built := false
+
+	defer func() {
+		if !built {
+			call.err = fmt.Errorf("%w: %s (abstract type): panicked while building", ErrInitializationFailed, b.key)
+		}
+
+		i.finishBuild(b, call)
+	}()
+
+	call.instance, call.err = i.build(withBuilding(ctx, call), b)
+	built = true
+
+	return call.instance, call.err
+}
+
+// finishBuild of the binding, caching its instance if it was successfully
+// built and releasing the calls waiting for it.
+func (i *BaseContainer) finishBuild(b *binding, call *buildCall) {
+	i.mx.Lock()
+
+	delete(i.building, b)
+
+	closed := i.closed
+	if call.err == nil && !closed {
+		i.instances[b] = call.instance
+		i.created = append(i.created, b)
+	}
+
+	i.mx.Unlock()
+
+	if call.err == nil && closed {
+		call.err = ErrContainerClosed
+
+		if err := dispose(call.instance); err != nil {
+			call.err = errors.Join(call.err, fmt.Errorf("%w: %s (abstract type): %w", ErrDisposeFailed, b.key, err))
+		}
+
+		call.instance = nil
+	}
+
+	close(call.done)
+}
+
+// callConstructor resolving each one of its parameters from the container.
+func (i *BaseContainer) callConstructor(ctx context.Context, key bindingKey, constructor reflect.Value) (any, error) {
+	fnType := constructor.Type()
+	args := make([]reflect.Value, fnType.NumIn())
+
+	for n := range args {
+		if handleType(fnType.In(n)) != nil {
+			args[n] = newHandle(fnType.In(n), i.view(ctx), "")
+			continue
+		}
+
+		arg, err := i.resolve(ctx, bindingKey{abstractType: fnType.In(n)})
+		if err != nil {
+			return nil, fmt.Errorf("%w, required by %s (abstract type)", err, key)
+		}
+
+		args[n] = reflect.ValueOf(containerView(ctx, fnType.In(n), arg))
+	}
+
+	results := constructor.Call(args)
+
+	if len(results) == 2 && !results[1].IsNil() {
+		return nil, fmt.Errorf("%w: %s (abstract type): %w", ErrFactoryFailed, key, results[1].Interface().(error))
+	}
+
+	return results[0].Interface(), nil
+}
+
+// injectFields of the struct value, resolving each one of them from the
+// container.
+func (i *BaseContainer) injectFields(ctx context.Context, key bindingKey, structValue reflect.Value, fields []injectField) error {
+	for _, f := range fields {
+		field := structValue.Field(f.index)
+
+		if handleType(field.Type()) != nil {
+			field.Set(newHandle(field.Type(), i.view(ctx), f.name))
+			continue
+		}
+
+		fieldKey := bindingKey{field.Type(), f.name}
+
+		if f.optional && !i.hasRelation(fieldKey) {
+			continue
+		}
+
+		instance, err := i.resolve(ctx, fieldKey)
+		if err != nil {
+			return fmt.Errorf("%w, required by %s (abstract type)", err, key)
+		}
+
+		field.Set(reflect.ValueOf(containerView(ctx, field.Type(), instance)))
+	}
+
+	return nil
+}
+
+// addRelation of the abstract type to the binding, according to the
+// registration options. It must be called with the mutex held.
+//
+// Multi-bindings are only added to the relations when they're named, so they
+// can also be injected by their name.
+func (i *BaseContainer) addRelation(abstractType reflect.Type, b *binding, r registration) error {
+	if i.closed {
+		return ErrContainerClosed
+	}
+
+	b.owner = i
+	b.key = bindingKey{abstractType, r.name}
+	b.lifetime = r.lifetime
+	b.multi = r.multi
+	b.eager = r.eager
+	b.module = r.module
+	b.site = registrationSite()
+
+	if !b.lifetime.isValid() || b.eager && b.lifetime != Singleton {
+		return fmt.Errorf("%w: %s (lifetime), %s (abstract type)", ErrLifetimeNotSupported, b.lifetime, b.key)
+	}
+
+	isRelation := !r.multi || r.name != ""
+
+	if existing, ok := i.relations[b.key]; ok && isRelation {
+		if existing.module != "" {
+			return fmt.Errorf("%w: %s (abstract type), owned by %s (module)", ErrAlreadyRegistered, b.key, existing.module)
+		}
+
+		return fmt.Errorf("%w: %s (abstract type)", ErrAlreadyRegistered, b.key)
+	}
+
+	if b.concreteType != nil && b.concreteType.Kind() == reflect.Pointer && isStruct(b.concreteType) {
+		b.concreteType = b.concreteType.Elem()
+	}
+
+	if isRelation {
+		i.relations[b.key] = b
+	}
+
+	if r.multi {
+		i.multi[abstractType] = append(i.multi[abstractType], b)
+	}
+
+	i.bindings = append(i.bindings, b)
+
+	return nil
+}
+
+// build a new concrete instance for the abstract type, either by calling the
+// registered factory or constructor, or by instantiating the concrete type.
+func (i *BaseContainer) build(ctx context.Context, b *binding) (any, error) {
+	key := b.key
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	var instance any
+	var err error
+
+	switch {
+	case b.factory != nil:
+		if instance, err = b.factory(); err != nil {
+			err = fmt.Errorf("%w: %s (abstract type): %w", ErrFactoryFailed, key, err)
+		}
+	case b.constructor.IsValid():
+		instance, err = i.callConstructor(ctx, key, b.constructor)
+	default:
+		value := reflect.New(b.concreteType)
+
+		if err := i.injectFields(ctx, key, value.Elem(), b.fields); err != nil {
+			return nil, err
+		}
+
+		instance = value.Interface()
+
+		if err := initialize(ctx, instance); err != nil {
+			return nil, fmt.Errorf("%w: %s (abstract type): %w", ErrInitializationFailed, key, err)
+		}
+
+		return instance, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if instance == nil {
+		return nil, fmt.Errorf("%w: %s (abstract type): nil instance returned", ErrFactoryFailed, key)
+	}
+
+	if !reflect.TypeOf(instance).Implements(key.abstractType) {
+		return nil, fmt.Errorf("%w: %s (concrete type), %s (abstract type)", ErrInterfaceNotImplemented, typeName(reflect.TypeOf(instance)), key)
+	}
+
+	return instance, nil
+}
+
+// checkRelation validates that the concrete type can be bound to the
+// abstract type, being instantiated by the container.
+func checkRelation(abstractType reflect.Type, concreteType reflect.Type) error {
+	if abstractType == nil || abstractType.Kind() != reflect.Interface {
+		return ErrNotAnInterface
+	}
+
+	if concreteType == nil || !isStruct(concreteType) {
+		return ErrNotAnStruct
+	}
+
+	return checkImplements(abstractType, concreteType)
+}
+
+// checkInstanceRelation validates that the concrete instance type can be
+// bound to the abstract type. Unlike the instantiated ones, it can be of any
+// type implementing the abstract type, like a func or map type.
+func checkInstanceRelation(abstractType reflect.Type, concreteType reflect.Type) error {
+	if abstractType == nil || abstractType.Kind() != reflect.Interface {
+		return ErrNotAnInterface
+	}
+
+	if concreteType == nil {
+		return ErrNotAnStruct
+	}
+
+	return checkImplements(abstractType, concreteType)
+}
+
+// checkImplements validates that the concrete type implements the abstract
+// type.
+func checkImplements(abstractType reflect.Type, concreteType reflect.Type) error {
+	if !concreteType.Implements(abstractType) {
+		return fmt.Errorf("%w: %s (concrete type), %s (abstract type)", ErrInterfaceNotImplemented, typeName(concreteType), typeName(abstractType))
+	}
+
+	return nil
+}
+
+// isStruct reports whether the type is a struct or *struct type.
+func isStruct(t reflect.Type) bool {
+	return t.Kind() == reflect.Struct || t.Kind() == reflect.Pointer && t.Elem().Kind() == reflect.Struct
+}
+
+// typeName of the type, or its description if it isn't a named type, like
+// *Config or func().
+func typeName(t reflect.Type) string {
+	if t.Name() == "" {
+		return t.String()
+	}
+
+	return t.Name()
+}
+
+// checkConstructor validates the constructor function signature, returning
+// the abstract type it builds.
+//
+// A constructor must be a non-variadic function returning an interface, and
+// optionally an error as the second value. Each one of its parameters must be
+// injectable, like the fields of [injectFields].
+func checkConstructor(fn reflect.Value, selfTypes bool) (reflect.Type, error) {
+	if !fn.IsValid() || fn.Kind() != reflect.Func || fn.IsNil() {
+		return nil, ErrNotAConstructor
+	}
+
+	fnType := fn.Type()
+
+	if fnType.IsVariadic() || fnType.NumOut() < 1 || fnType.NumOut() > 2 {
+		return nil, fmt.Errorf("%w: %s", ErrNotAConstructor, fnType)
+	}
+
+	if fnType.NumOut() == 2 && fnType.Out(1) != reflect.TypeFor[error]() {
+		return nil, fmt.Errorf("%w: %s", ErrNotAConstructor, fnType)
+	}
+
+	if fnType.Out(0).Kind() != reflect.Interface {
+		return nil, fmt.Errorf("%w: %s", ErrNotAnInterface, fnType)
+	}
+
+	for n := range fnType.NumIn() {
+		if isHandleValue(fnType.In(n)) {
+			return nil, fmt.Errorf("%w: %s: parameter %d: handle must be a pointer", ErrFieldNotInjectable, fnType, n)
+		}
+
+		if !isInjectable(fnType.In(n), selfTypes) {
+			return nil, fmt.Errorf("%w: %s: parameter %d isn't injectable", ErrNotAConstructor, fnType, n)
+		}
+	}
+
+	return fnType.Out(0), nil
+}
+
+// buildCall of a binding being built, shared by the concurrent calls waiting
+// for its instance.
+//
+// It's carried by the context of the build, so the calls of the bindings
+// built for it are chained to it by their parent.
+type buildCall struct {
+	binding  *binding
+	parent   *buildCall
+	done     chan struct{}
+	instance any
+	err      error
+}
+
+// newBuildCall of the binding, chained to the call building the context.
+func newBuildCall(ctx context.Context, b *binding) *buildCall {
+	return &buildCall{binding: b, parent: buildingCall(ctx), done: make(chan struct{})}
+}
+
+// finished reports whether the call is done building.
+func (c *buildCall) finished() bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// chain of the bindings being built, from the outermost call to this one.
+func (c *buildCall) chain() []*binding {
+	var chain []*binding
+	for ; c != nil; c = c.parent {
+		chain = append(chain, c.binding)
+	}
+
+	slices.Reverse(chain)
+
+	return chain
+}
+
+// descendantPath returns the bindings being built from the call down to the
+// descendant one, or nil if it isn't chained to the call.
+func (c *buildCall) descendantPath(descendant *buildCall) []*binding {
+	var path []*binding
+	for d := descendant; d != nil; d = d.parent {
+		path = append(path, d.binding)
+
+		if d == c {
+			slices.Reverse(path)
+			return path
+		}
+	}
+
+	return nil
+}
+
+// buildingKey is the context key holding the innermost call being built.
+type buildingKey struct{}
+
+// buildingCall of the context, or nil if it isn't building anything.
+func buildingCall(ctx context.Context) *buildCall {
+	call, _ := ctx.Value(buildingKey{}).(*buildCall)
+	return call
+}
+
+// withBuilding returns a copy of the context building the call.
+func withBuilding(ctx context.Context, call *buildCall) context.Context {
+	return context.WithValue(ctx, buildingKey{}, call)
+}
+
+// waitFor edge of a call waiting for another one, built by another
+// goroutine.
+type waitFor struct {
+	waiter *buildCall
+	call   *buildCall
+}
+
+// waits holds the calls waiting for other ones, across every container, to
+// find the circular dependencies split between goroutines.
+var (
+	waits   = make(map[*waitFor]struct{})
+	waitsMx sync.Mutex
+)
+
+// wait for the call built by another goroutine. It fails with
+// [ErrCircularDependency] instead if the call building the context is
+// depended on by it, even through calls waiting for other ones, since it'd
+// wait forever.
+func wait(ctx context.Context, call *buildCall) (any, error) {
+	if waiter := buildingCall(ctx); waiter != nil {
+		waitsMx.Lock()
+
+		if path := waitCycle(waiter, call); path != nil {
+			waitsMx.Unlock()
+			return nil, circularDependency(append([]*binding{waiter.binding}, path...))
+		}
+
+		edge := &waitFor{waiter, call}
+		waits[edge] = struct{}{}
+
+		waitsMx.Unlock()
+
+		defer func() {
+			waitsMx.Lock()
+			delete(waits, edge)
+			waitsMx.Unlock()
+		}()
+	}
+
+	select {
+	case <-call.done:
+		return call.instance, call.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// waitCycle returns the dependency path from the call to the waiter if the
+// call can't finish before it, or nil otherwise. It must be called with the
+// waits mutex held.
+//
+// A call depends on the ones chained to it, and on the ones they're waiting
+// for, which are followed until reaching the waiter.
+func waitCycle(waiter *buildCall, call *buildCall) []*binding {
+	paths := map[*buildCall][]*binding{call: {call.binding}}
+	pending := []*buildCall{call}
+
+	for len(pending) > 0 {
+		c := pending[0]
+		pending = pending[1:]
+
+		if path := c.descendantPath(waiter); path != nil {
+			return append(paths[c], path[1:]...)
+		}
+
+		for edge := range waits {
+			path := c.descendantPath(edge.waiter)
+			if path == nil || edge.call.finished() {
+				continue
+			}
+
+			if _, ok := paths[edge.call]; ok {
+				continue
+			}
+
+			paths[edge.call] = append(append(paths[c][:len(paths[c]):len(paths[c])], path[1:]...), edge.call.binding)
+			pending = append(pending, edge.call)
+		}
+	}
+
+	return nil
+}
+
+// cycle returns the dependency path from the binding to itself if it's one of
+// the bindings being built, or nil otherwise.
+func cycle(building []*binding, b *binding) []*binding {
+	for n, inFlight := range building {
+		if inFlight == b {
+			return append(building[n:len(building):len(building)], b)
+		}
+	}
+
+	return nil
+}
+
+// circularDependency error describing the dependency path, ending with the
+// binding depended on by the last one.
+func circularDependency(path []*binding) error {
+	keys := make([]string, len(path))
+	for n, b := range path {
+		keys[n] = b.key.String()
+	}
+
+	return fmt.Errorf("%w: %s", ErrCircularDependency, strings.Join(keys, " -> "))
+}
+
+// initialize the instance if it implements any of the
+// [InitializableDependency], [InitializableDependencyWithError] or
+// [InitializableDependencyWithContext] interfaces.
+func initialize(ctx context.Context, instance any) error {
+	switch d := instance.(type) {
+	case InitializableDependency:
+		d.InitializeDependency()
+		return nil
+	case InitializableDependencyWithError:
+		return d.InitializeDependency()
+	case InitializableDependencyWithContext:
+		return d.InitializeDependency(ctx)
+	default:
+		return nil
+	}
+}
+
+// disposeAll the instances built for the bindings, in reverse creation order,
+// joining every failure.
+func disposeAll(created []*binding, instances []any) error {
+	var errs []error
+
+	for n := len(created) - 1; n >= 0; n-- {
+		if err := dispose(instances[n]); err != nil {
+			errs = append(errs, fmt.Errorf("%w: %s (abstract type): %w", ErrDisposeFailed, created[n].key, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// dispose the instance if it implements the [DisposableDependency] or the
+// [io.Closer] interfaces.
+func dispose(instance any) error {
+	switch d := instance.(type) {
+	case DisposableDependency:
+		return d.DisposeDependency()
+	case io.Closer:
+		return d.Close()
+	default:
+		return nil
+	}
+}
+
+// must panics with err if it isn't nil.
+func must(err error) {
+	if err != nil {
+		panic(err)
+	}
 }
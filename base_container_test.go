@@ -1,9 +1,15 @@
 package goinject_test
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	goinject "github.com/d1360-64rc14/go-inject"
 )
@@ -23,6 +29,9 @@ type TestD interface {
 type TestE interface {
 	MethodTestE()
 }
+type TestF interface {
+	MethodTestF()
+}
 
 type TestAImpl struct{}
 type TestBImpl struct{}
@@ -31,6 +40,10 @@ type TestCImpl struct {
 }
 type TestDImpl struct{}
 type TestEImpl struct{}
+type TestFImpl struct {
+	A TestA
+	C TestC
+}
 
 func (a *TestAImpl) InitializeDependency() {}
 func (a *TestAImpl) MethodTestA()          {}
@@ -44,6 +57,11 @@ func (d *TestDImpl) MethodTestD()          {}
 func (e *TestEImpl) InitializeDependency() {}
 func (e *TestEImpl) MethodTestE()          {}
 func (e *TestEImpl) MethodTestA()          {}
+func (f *TestFImpl) MethodTestF()          {}
+
+func NewTestF(a TestA, c TestC) (TestF, error) {
+	return &TestFImpl{A: a, C: c}, nil
+}
 
 func TestBaseInjector(t *testing.T) {
 	t.Run("RegisterType", func(t *testing.T) {
@@ -468,6 +486,2782 @@ func TestBaseInjectorInject(t *testing.T) {
 	}
 }
 
+func TestBaseInjectorTry(t *testing.T) {
+	t.Run("TryRegisterType", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+
+		if err := i.TryRegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]()); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		err := i.TryRegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestEImpl]())
+		if !errors.Is(err, goinject.ErrAlreadyRegistered) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrAlreadyRegistered, err)
+		}
+	})
+
+	t.Run("TryRegister", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+
+		if err := i.TryRegister(reflect.TypeFor[TestA](), &TestAImpl{}); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		err := i.TryRegister(reflect.TypeFor[TestB](), &TestAImpl{})
+		if !errors.Is(err, goinject.ErrInterfaceNotImplemented) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrInterfaceNotImplemented, err)
+		}
+	})
+
+	t.Run("TryInject", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl]())
+
+		instance, err := i.TryInject(reflect.TypeFor[TestC]())
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if _, ok := instance.(*TestCImpl); !ok {
+			t.Errorf("expected *TestCImpl, got %T", instance)
+		}
+
+		_, err = i.TryInject(reflect.TypeFor[TestD]())
+		if !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+	})
+}
+
+func TestBaseInjectorRegisterFactory(t *testing.T) {
+	t.Run("Lazy and cached", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		calls := 0
+
+		i.RegisterFactory(reflect.TypeFor[TestC](), func() (any, error) {
+			calls++
+			return &TestCImpl{}, nil
+		})
+
+		if calls != 0 {
+			t.Errorf("expected factory not to be called on registration, got %d calls", calls)
+			return
+		}
+
+		first := i.Inject(reflect.TypeFor[TestC]()).(*TestCImpl)
+		second := i.Inject(reflect.TypeFor[TestC]()).(*TestCImpl)
+
+		if first != second {
+			t.Error("expected the same instance on every injection")
+		}
+
+		if calls != 1 {
+			t.Errorf("expected factory to be called once, got %d calls", calls)
+		}
+
+		if first.Executed {
+			t.Error("method Initialize shouldn't be called on factory instances")
+		}
+	})
+
+	t.Run("Failed factory isn't cached", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		factoryErr := errors.New("connection refused")
+		calls := 0
+
+		i.RegisterFactory(reflect.TypeFor[TestA](), func() (any, error) {
+			calls++
+			if calls == 1 {
+				return nil, factoryErr
+			}
+			return &TestAImpl{}, nil
+		})
+
+		_, err := i.TryInject(reflect.TypeFor[TestA]())
+		if !errors.Is(err, goinject.ErrFactoryFailed) || !errors.Is(err, factoryErr) {
+			t.Errorf("expected error '%v', got '%v'", factoryErr, err)
+			return
+		}
+
+		if _, err := i.TryInject(reflect.TypeFor[TestA]()); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+	})
+
+	t.Run("Wrong instance type", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+
+		i.RegisterFactory(reflect.TypeFor[TestB](), func() (any, error) {
+			return &TestAImpl{}, nil
+		})
+
+		_, err := i.TryInject(reflect.TypeFor[TestB]())
+		if !errors.Is(err, goinject.ErrInterfaceNotImplemented) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrInterfaceNotImplemented, err)
+		}
+	})
+
+	t.Run("Registration errors", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+
+		err := i.TryRegisterFactory(reflect.TypeFor[TestA](), nil)
+		if !errors.Is(err, goinject.ErrNilFactory) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNilFactory, err)
+		}
+
+		err = i.TryRegisterFactory(reflect.TypeFor[*TestAImpl](), func() (any, error) { return nil, nil })
+		if !errors.Is(err, goinject.ErrNotAnInterface) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNotAnInterface, err)
+		}
+	})
+}
+
+func TestBaseInjectorRegisterConstructor(t *testing.T) {
+	t.Run("Resolve parameters", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+
+		err := recoverPanic(func() {
+			i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+			i.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl]())
+			i.RegisterConstructor(NewTestF)
+		})
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		var testF *TestFImpl
+
+		err = recoverPanic(func() {
+			testF = i.Inject(reflect.TypeFor[TestF]()).(*TestFImpl)
+		})
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if testF.A != i.Inject(reflect.TypeFor[TestA]()) {
+			t.Error("expected parameter A to be the registered instance")
+		}
+
+		if testF.C != i.Inject(reflect.TypeFor[TestC]()) {
+			t.Error("expected parameter C to be the registered instance")
+		}
+
+		if testF != i.Inject(reflect.TypeFor[TestF]()) {
+			t.Error("expected the same instance on every injection")
+		}
+	})
+
+	t.Run("Missing parameter", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+		i.RegisterConstructor(NewTestF)
+
+		_, err := i.TryInject(reflect.TypeFor[TestF]())
+		if !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+	})
+
+	t.Run("Constructor error", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		constructorErr := errors.New("invalid configuration")
+
+		i.RegisterConstructor(func() (TestA, error) {
+			return nil, constructorErr
+		})
+
+		_, err := i.TryInject(reflect.TypeFor[TestA]())
+		if !errors.Is(err, goinject.ErrFactoryFailed) || !errors.Is(err, constructorErr) {
+			t.Errorf("expected error '%v', got '%v'", constructorErr, err)
+		}
+	})
+}
+
+func TestBaseInjectorRegisterConstructorErrors(t *testing.T) {
+	testCases := []struct {
+		desc        string
+		constructor any
+		opts        []goinject.ContainerOption
+		err         error
+	}{
+		{
+			desc:        "Right constructor",
+			constructor: NewTestF,
+			err:         nil,
+		},
+		{
+			desc:        "Constructor without error",
+			constructor: func(TestA) TestB { return &TestBImpl{} },
+			err:         nil,
+		},
+		{
+			desc:        "Nil constructor",
+			constructor: nil,
+			err:         goinject.ErrNotAConstructor,
+		},
+		{
+			desc:        "Not a function",
+			constructor: &TestAImpl{},
+			err:         goinject.ErrNotAConstructor,
+		},
+		{
+			desc:        "No return values",
+			constructor: func(TestA) {},
+			err:         goinject.ErrNotAConstructor,
+		},
+		{
+			desc:        "Second return value isn't an error",
+			constructor: func() (TestA, bool) { return nil, false },
+			err:         goinject.ErrNotAConstructor,
+		},
+		{
+			desc:        "Variadic function",
+			constructor: func(...TestA) TestB { return nil },
+			err:         goinject.ErrNotAConstructor,
+		},
+		{
+			desc:        "Return value is not an interface",
+			constructor: func() *TestAImpl { return nil },
+			err:         goinject.ErrNotAnInterface,
+		},
+		{
+			desc:        "Parameter isn't injectable",
+			constructor: func(string) TestB { return nil },
+			err:         goinject.ErrNotAConstructor,
+		},
+		{
+			desc:        "Handle parameter of a non-injectable type",
+			constructor: func(*goinject.Lazy[string]) TestB { return nil },
+			err:         goinject.ErrNotAConstructor,
+		},
+		{
+			desc:        "Self-binding parameter",
+			constructor: func(*TestConfig, goinject.Provider[*TestConfig]) TestB { return nil },
+			err:         nil,
+		},
+		{
+			desc:        "Self-binding parameter of a strict container",
+			constructor: func(*TestConfig) TestB { return nil },
+			opts:        []goinject.ContainerOption{goinject.Strict()},
+			err:         goinject.ErrNotAConstructor,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			t.Parallel()
+
+			i := goinject.NewBaseContainer(tC.opts...)
+
+			err := i.TryRegisterConstructor(tC.constructor)
+
+			if !errors.Is(err, tC.err) {
+				t.Errorf("expected error '%v', got '%v'", tC.err, err)
+			}
+		})
+	}
+}
+
+type TestFieldsImpl struct {
+	A           TestA `inject:""`
+	B           TestB `inject:"optional"`
+	C           TestC `inject:"optional"`
+	NotInjected TestD
+
+	InitializedWithA bool
+}
+
+func (f *TestFieldsImpl) InitializeDependency() {
+	f.InitializedWithA = f.A != nil
+}
+func (f *TestFieldsImpl) MethodTestF() {}
+
+type TestUnexportedFieldImpl struct {
+	a TestA `inject:""`
+}
+
+func (f *TestUnexportedFieldImpl) MethodTestF() {}
+
+type TestNonInterfaceFieldImpl struct {
+	A string `inject:""`
+}
+
+func (f *TestNonInterfaceFieldImpl) MethodTestF() {}
+
+type TestUnknownOptionFieldImpl struct {
+	A TestA `inject:"eager"`
+}
+
+func (f *TestUnknownOptionFieldImpl) MethodTestF() {}
+
+func TestBaseInjectorFieldInjection(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+
+		err := recoverPanic(func() {
+			i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+			i.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl]())
+			i.RegisterType(reflect.TypeFor[TestD](), reflect.TypeFor[*TestDImpl]())
+			i.RegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestFieldsImpl]())
+		})
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		var testF *TestFieldsImpl
+
+		err = recoverPanic(func() {
+			testF = i.Inject(reflect.TypeFor[TestF]()).(*TestFieldsImpl)
+		})
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if testF.A != i.Inject(reflect.TypeFor[TestA]()) {
+			t.Error("expected field A to be injected")
+		}
+
+		if testF.B != nil {
+			t.Error("expected optional field B to be nil")
+		}
+
+		if testF.C != i.Inject(reflect.TypeFor[TestC]()) {
+			t.Error("expected optional field C to be injected")
+		}
+
+		if testF.NotInjected != nil {
+			t.Error("expected untagged field to be nil")
+		}
+
+		if !testF.InitializedWithA {
+			t.Error("expected fields to be injected before InitializeDependency")
+		}
+	})
+
+	t.Run("Missing required field", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestFieldsImpl]())
+
+		_, err := i.TryInject(reflect.TypeFor[TestF]())
+		if !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+	})
+}
+
+func TestBaseInjectorFieldInjectionErrors(t *testing.T) {
+	testCases := []struct {
+		desc         string
+		concreteType reflect.Type
+		err          error
+	}{
+		{
+			desc:         "Right tagged fields",
+			concreteType: reflect.TypeFor[*TestFieldsImpl](),
+			err:          nil,
+		},
+		{
+			desc:         "Unexported tagged field",
+			concreteType: reflect.TypeFor[*TestUnexportedFieldImpl](),
+			err:          goinject.ErrFieldNotInjectable,
+		},
+		{
+			desc:         "Unknown tag option",
+			concreteType: reflect.TypeFor[*TestUnknownOptionFieldImpl](),
+			err:          goinject.ErrFieldNotInjectable,
+		},
+		{
+			desc:         "Non interface tagged field",
+			concreteType: reflect.TypeFor[*TestNonInterfaceFieldImpl](),
+			err:          goinject.ErrNotAnInterface,
+		},
+	}
+
+	for _, tC := range testCases {
+		t.Run(tC.desc, func(t *testing.T) {
+			t.Parallel()
+
+			i := goinject.NewBaseContainer()
+
+			err := i.TryRegisterType(reflect.TypeFor[TestF](), tC.concreteType)
+
+			if !errors.Is(err, tC.err) {
+				t.Errorf("expected error '%v', got '%v'", tC.err, err)
+			}
+		})
+	}
+}
+
+type TestNamedFieldsImpl struct {
+	Primary TestA `inject:""`
+	Replica TestA `inject:"name=replica"`
+	Backup  TestA `inject:"name=backup,optional"`
+}
+
+func (f *TestNamedFieldsImpl) MethodTestF() {}
+
+func TestBaseInjectorNamed(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		primary := &TestAImpl{}
+		replica := &TestAImpl{}
+
+		err := recoverPanic(func() {
+			i.Register(reflect.TypeFor[TestA](), primary)
+			i.Register(reflect.TypeFor[TestA](), replica, goinject.Named("replica"))
+			i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestEImpl](), goinject.Named("other"))
+		})
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if got := i.Inject(reflect.TypeFor[TestA]()); got != primary {
+			t.Errorf("expected the default instance, got '%v'", got)
+		}
+
+		if got := i.InjectNamed(reflect.TypeFor[TestA](), ""); got != primary {
+			t.Errorf("expected the default instance, got '%v'", got)
+		}
+
+		if got := i.InjectNamed(reflect.TypeFor[TestA](), "replica"); got != replica {
+			t.Errorf("expected the replica instance, got '%v'", got)
+		}
+
+		if _, ok := i.InjectNamed(reflect.TypeFor[TestA](), "other").(*TestEImpl); !ok {
+			t.Error("expected the other instance to be *TestEImpl")
+		}
+	})
+
+	t.Run("Already registered", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl](), goinject.Named("replica"))
+
+		err := i.TryRegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl](), goinject.Named("replica"))
+		if !errors.Is(err, goinject.ErrAlreadyRegistered) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrAlreadyRegistered, err)
+		}
+	})
+
+	t.Run("Not registered name", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+
+		_, err := i.TryInjectNamed(reflect.TypeFor[TestA](), "replica")
+		if !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+	})
+
+	t.Run("Named fields", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		primary := &TestAImpl{}
+		replica := &TestAImpl{}
+
+		i.Register(reflect.TypeFor[TestA](), primary)
+		i.Register(reflect.TypeFor[TestA](), replica, goinject.Named("replica"))
+		i.RegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestNamedFieldsImpl]())
+
+		testF, err := i.TryInject(reflect.TypeFor[TestF]())
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		fields := testF.(*TestNamedFieldsImpl)
+
+		if fields.Primary != primary {
+			t.Error("expected field Primary to be the default instance")
+		}
+
+		if fields.Replica != replica {
+			t.Error("expected field Replica to be the replica instance")
+		}
+
+		if fields.Backup != nil {
+			t.Error("expected optional field Backup to be nil")
+		}
+	})
+}
+
+func TestBaseInjectorMulti(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		single := &TestCImpl{}
+		second := &TestCImpl{}
+		third := &TestCImpl{}
+
+		err := recoverPanic(func() {
+			i.Register(reflect.TypeFor[TestC](), single)
+			i.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl](), goinject.Multi())
+			i.Register(reflect.TypeFor[TestC](), second, goinject.Multi())
+			i.RegisterFactory(reflect.TypeFor[TestC](), func() (any, error) { return third, nil }, goinject.Multi())
+		})
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		var all []any
+
+		err = recoverPanic(func() {
+			all = i.InjectAll(reflect.TypeFor[TestC]())
+		})
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if len(all) != 3 {
+			t.Errorf("expected 3 instances, got %d", len(all))
+			return
+		}
+
+		if first := all[0].(*TestCImpl); !first.Executed {
+			t.Error("method Initialize was not called")
+		}
+
+		if all[1] != second || all[2] != third {
+			t.Error("expected instances in registration order")
+		}
+
+		if got := i.Inject(reflect.TypeFor[TestC]()); got != single {
+			t.Errorf("expected the single instance, got '%v'", got)
+		}
+
+		if again := i.InjectAll(reflect.TypeFor[TestC]()); again[0] != all[0] {
+			t.Error("expected the same instances on every injection")
+		}
+	})
+
+	t.Run("Named contribution", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		db := &TestCImpl{}
+
+		i.Register(reflect.TypeFor[TestC](), db, goinject.Multi(), goinject.Named("db"))
+
+		if got := i.InjectNamed(reflect.TypeFor[TestC](), "db"); got != db {
+			t.Errorf("expected the db instance, got '%v'", got)
+		}
+
+		err := i.TryRegister(reflect.TypeFor[TestC](), &TestCImpl{}, goinject.Multi(), goinject.Named("db"))
+		if !errors.Is(err, goinject.ErrAlreadyRegistered) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrAlreadyRegistered, err)
+		}
+	})
+
+	t.Run("Nothing registered", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+
+		all, err := i.TryInjectAll(reflect.TypeFor[TestC]())
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+
+		if all == nil || len(all) != 0 {
+			t.Errorf("expected empty slice, got '%v'", all)
+		}
+
+		_, err = i.TryInjectAll(reflect.TypeFor[*TestCImpl]())
+		if !errors.Is(err, goinject.ErrNotAnInterface) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNotAnInterface, err)
+		}
+	})
+}
+
+func TestBaseInjectorTransient(t *testing.T) {
+	t.Run("RegisterType", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl](), goinject.WithLifetime(goinject.Transient))
+
+		first := i.Inject(reflect.TypeFor[TestC]()).(*TestCImpl)
+		second := i.Inject(reflect.TypeFor[TestC]()).(*TestCImpl)
+
+		if first == second {
+			t.Error("expected a new instance on every injection")
+		}
+
+		if !first.Executed || !second.Executed {
+			t.Error("method Initialize was not called")
+		}
+	})
+
+	t.Run("RegisterFactory", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		calls := 0
+
+		i.RegisterFactory(reflect.TypeFor[TestC](), func() (any, error) {
+			calls++
+			return &TestCImpl{}, nil
+		}, goinject.WithLifetime(goinject.Transient))
+
+		i.Inject(reflect.TypeFor[TestC]())
+		i.Inject(reflect.TypeFor[TestC]())
+
+		if calls != 2 {
+			t.Errorf("expected factory to be called twice, got %d calls", calls)
+		}
+	})
+
+	t.Run("Register", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+
+		err := i.TryRegister(reflect.TypeFor[TestC](), &TestCImpl{}, goinject.WithLifetime(goinject.Transient))
+		if !errors.Is(err, goinject.ErrLifetimeNotSupported) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrLifetimeNotSupported, err)
+		}
+	})
+
+	t.Run("Unknown lifetime", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+
+		err := i.TryRegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl](), goinject.WithLifetime(goinject.Lifetime(-1)))
+		if !errors.Is(err, goinject.ErrLifetimeNotSupported) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrLifetimeNotSupported, err)
+		}
+	})
+}
+
+type TestScopedImpl struct {
+	A TestA `inject:""`
+}
+
+func (f *TestScopedImpl) MethodTestF() {}
+
+func TestBaseInjectorScope(t *testing.T) {
+	t.Run("Scoped instances", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestFImpl]())
+		i.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl](), goinject.WithLifetime(goinject.Scoped))
+
+		first := i.NewScope()
+		second := i.NewScope()
+
+		if first.Inject(reflect.TypeFor[TestC]()) != first.Inject(reflect.TypeFor[TestC]()) {
+			t.Error("expected the same scoped instance inside the scope")
+		}
+
+		if first.Inject(reflect.TypeFor[TestC]()) == second.Inject(reflect.TypeFor[TestC]()) {
+			t.Error("expected a different scoped instance for each scope")
+		}
+
+		if first.Inject(reflect.TypeFor[TestF]()) != second.Inject(reflect.TypeFor[TestF]()) {
+			t.Error("expected singleton instances to be shared between scopes")
+		}
+
+		if first.Inject(reflect.TypeFor[TestF]()) != i.Inject(reflect.TypeFor[TestF]()) {
+			t.Error("expected singleton instances to be shared with the container")
+		}
+
+		if i.Inject(reflect.TypeFor[TestC]()) != i.Inject(reflect.TypeFor[TestC]()) {
+			t.Error("expected the container to behave as a scope")
+		}
+	})
+
+	t.Run("Scope relations", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestScopedImpl](), goinject.WithLifetime(goinject.Scoped))
+		i.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl]())
+
+		scope := i.NewScope()
+		request := &TestAImpl{}
+		shadow := &TestCImpl{}
+
+		err := recoverPanic(func() {
+			scope.Register(reflect.TypeFor[TestA](), request)
+			scope.Register(reflect.TypeFor[TestC](), shadow)
+		})
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if testF := scope.Inject(reflect.TypeFor[TestF]()).(*TestScopedImpl); testF.A != request {
+			t.Error("expected scoped instance to be built with the scope relations")
+		}
+
+		if scope.Inject(reflect.TypeFor[TestC]()) != shadow {
+			t.Error("expected scope relation to shadow the container one")
+		}
+
+		if _, err := i.TryInject(reflect.TypeFor[TestA]()); !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+	})
+
+	t.Run("InjectAll", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		fromContainer := &TestCImpl{}
+		fromScope := &TestCImpl{}
+
+		i.Register(reflect.TypeFor[TestC](), fromContainer, goinject.Multi())
+
+		scope := i.NewScope()
+		scope.Register(reflect.TypeFor[TestC](), fromScope, goinject.Multi())
+
+		all := scope.InjectAll(reflect.TypeFor[TestC]())
+		if len(all) != 2 || all[0] != fromContainer || all[1] != fromScope {
+			t.Errorf("expected container and scope instances, got '%v'", all)
+		}
+	})
+}
+
+type TestDisposable interface {
+	MethodTestDisposable()
+}
+
+type TestDisposableImpl struct {
+	Name     string
+	Disposed *[]string
+	Err      error
+}
+
+func (d *TestDisposableImpl) MethodTestDisposable() {}
+func (d *TestDisposableImpl) DisposeDependency() error {
+	*d.Disposed = append(*d.Disposed, d.Name)
+	return d.Err
+}
+
+type TestCloserImpl struct {
+	Closed bool
+}
+
+func (c *TestCloserImpl) MethodTestA() {}
+func (c *TestCloserImpl) Close() error {
+	c.Closed = true
+	return nil
+}
+
+func TestBaseInjectorClose(t *testing.T) {
+	t.Run("Reverse creation order", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		disposed := []string{}
+		registered := &TestDisposableImpl{Name: "registered", Disposed: &disposed}
+
+		newDisposable := func(name string) func() (any, error) {
+			return func() (any, error) {
+				return &TestDisposableImpl{Name: name, Disposed: &disposed}, nil
+			}
+		}
+
+		i.Register(reflect.TypeFor[TestDisposable](), registered, goinject.Named("registered"))
+		i.RegisterFactory(reflect.TypeFor[TestDisposable](), newDisposable("first"), goinject.Named("first"))
+		i.RegisterFactory(reflect.TypeFor[TestDisposable](), newDisposable("second"), goinject.Named("second"))
+		i.RegisterFactory(reflect.TypeFor[TestDisposable](), newDisposable("transient"), goinject.WithLifetime(goinject.Transient))
+		i.RegisterFactory(reflect.TypeFor[TestDisposable](), newDisposable("never"), goinject.Named("never"))
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestCloserImpl]())
+
+		i.InjectNamed(reflect.TypeFor[TestDisposable](), "registered")
+		i.InjectNamed(reflect.TypeFor[TestDisposable](), "first")
+		i.Inject(reflect.TypeFor[TestDisposable]())
+		i.InjectNamed(reflect.TypeFor[TestDisposable](), "second")
+		closer := i.Inject(reflect.TypeFor[TestA]()).(*TestCloserImpl)
+
+		if err := i.Close(); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if !reflect.DeepEqual(disposed, []string{"second", "first"}) {
+			t.Errorf("expected [second first] to be disposed, got '%v'", disposed)
+		}
+
+		if !closer.Closed {
+			t.Error("expected io.Closer to be closed")
+		}
+
+		if err := i.Close(); err != nil {
+			t.Errorf("unexpected error on second close: '%v'", err)
+		}
+	})
+
+	t.Run("Joined errors", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		disposed := []string{}
+		firstErr := errors.New("first failed")
+		secondErr := errors.New("second failed")
+
+		i.RegisterFactory(reflect.TypeFor[TestDisposable](), func() (any, error) {
+			return &TestDisposableImpl{Name: "first", Disposed: &disposed, Err: firstErr}, nil
+		}, goinject.Named("first"))
+		i.RegisterFactory(reflect.TypeFor[TestDisposable](), func() (any, error) {
+			return &TestDisposableImpl{Name: "second", Disposed: &disposed, Err: secondErr}, nil
+		}, goinject.Named("second"))
+
+		i.InjectNamed(reflect.TypeFor[TestDisposable](), "first")
+		i.InjectNamed(reflect.TypeFor[TestDisposable](), "second")
+
+		err := i.Close()
+
+		if !errors.Is(err, goinject.ErrDisposeFailed) || !errors.Is(err, firstErr) || !errors.Is(err, secondErr) {
+			t.Errorf("expected both dispose errors, got '%v'", err)
+		}
+
+		if len(disposed) != 2 {
+			t.Errorf("expected every instance to be disposed, got '%v'", disposed)
+		}
+	})
+
+	t.Run("Closed container", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+		i.Inject(reflect.TypeFor[TestA]())
+
+		scope := i.NewScope()
+
+		if err := i.Close(); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if _, err := i.TryInject(reflect.TypeFor[TestA]()); !errors.Is(err, goinject.ErrContainerClosed) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrContainerClosed, err)
+		}
+
+		if _, err := i.TryInjectAll(reflect.TypeFor[TestA]()); !errors.Is(err, goinject.ErrContainerClosed) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrContainerClosed, err)
+		}
+
+		if err := i.TryRegisterType(reflect.TypeFor[TestB](), reflect.TypeFor[*TestBImpl]()); !errors.Is(err, goinject.ErrContainerClosed) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrContainerClosed, err)
+		}
+
+		if _, err := scope.TryInject(reflect.TypeFor[TestA]()); !errors.Is(err, goinject.ErrContainerClosed) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrContainerClosed, err)
+		}
+	})
+
+	t.Run("Scope", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestCloserImpl](), goinject.WithLifetime(goinject.Scoped))
+
+		scope := i.NewScope()
+		scoped := scope.Inject(reflect.TypeFor[TestA]()).(*TestCloserImpl)
+		root := i.Inject(reflect.TypeFor[TestA]()).(*TestCloserImpl)
+
+		if err := scope.Close(); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if !scoped.Closed {
+			t.Error("expected scoped instance to be closed")
+		}
+
+		if root.Closed {
+			t.Error("expected container instance not to be closed with the scope")
+		}
+	})
+}
+
+type testContextKey struct{}
+
+var errTestInitialization = errors.New("initialization failed")
+
+type TestAttempts interface {
+	Next() int
+}
+
+type TestAttemptsImpl struct {
+	n int
+}
+
+func (a *TestAttemptsImpl) Next() int {
+	a.n++
+	return a.n
+}
+
+type TestFallibleImpl struct {
+	Attempts TestAttempts `inject:""`
+}
+
+func (f *TestFallibleImpl) MethodTestA() {}
+func (f *TestFallibleImpl) InitializeDependency() error {
+	if f.Attempts.Next() == 1 {
+		return errTestInitialization
+	}
+	return nil
+}
+
+type TestContextImpl struct {
+	Value any
+}
+
+func (c *TestContextImpl) MethodTestC() {}
+func (c *TestContextImpl) InitializeDependency(ctx context.Context) error {
+	c.Value = ctx.Value(testContextKey{})
+	return nil
+}
+
+func TestBaseInjectorInitialization(t *testing.T) {
+	t.Run("With error", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestAttempts](), reflect.TypeFor[*TestAttemptsImpl]())
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestFallibleImpl]())
+
+		_, err := i.TryInject(reflect.TypeFor[TestA]())
+		if !errors.Is(err, goinject.ErrInitializationFailed) || !errors.Is(err, errTestInitialization) {
+			t.Errorf("expected error '%v', got '%v'", errTestInitialization, err)
+			return
+		}
+
+		first, err := i.TryInject(reflect.TypeFor[TestA]())
+		if err != nil {
+			t.Errorf("expected failed instance not to be cached, got '%v'", err)
+			return
+		}
+
+		if second := i.Inject(reflect.TypeFor[TestA]()); first != second {
+			t.Error("expected the same instance after a successful initialization")
+		}
+	})
+
+	t.Run("With context", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestContextImpl]())
+
+		ctx := context.WithValue(context.Background(), testContextKey{}, "request")
+
+		testC, err := i.InjectContext(ctx, reflect.TypeFor[TestC]())
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if value := testC.(*TestContextImpl).Value; value != "request" {
+			t.Errorf("expected context value 'request', got '%v'", value)
+		}
+	})
+
+	t.Run("Canceled context", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestContextImpl]())
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := i.InjectContext(ctx, reflect.TypeFor[TestC]())
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected error '%v', got '%v'", context.Canceled, err)
+		}
+	})
+}
+
+type TestCycleAImpl struct {
+	B TestB `inject:""`
+}
+type TestCycleBImpl struct {
+	C TestC `inject:""`
+}
+type TestCycleCImpl struct {
+	A TestA `inject:""`
+}
+
+func (c *TestCycleAImpl) MethodTestA() {}
+func (c *TestCycleBImpl) MethodTestB() {}
+func (c *TestCycleCImpl) MethodTestC() {}
+
+func TestBaseInjectorCircularDependency(t *testing.T) {
+	t.Run("Fields", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestCycleAImpl]())
+		i.RegisterType(reflect.TypeFor[TestB](), reflect.TypeFor[*TestCycleBImpl]())
+		i.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCycleCImpl]())
+
+		_, err := i.TryInject(reflect.TypeFor[TestA]())
+		if !errors.Is(err, goinject.ErrCircularDependency) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrCircularDependency, err)
+			return
+		}
+
+		if !strings.Contains(err.Error(), "TestA -> TestB -> TestC -> TestA") {
+			t.Errorf("expected the dependency path in '%v'", err)
+		}
+	})
+
+	t.Run("Constructors", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterConstructor(func(TestB) TestA { return &TestAImpl{} })
+		i.RegisterConstructor(func(TestA) TestB { return &TestBImpl{} }, goinject.WithLifetime(goinject.Transient))
+
+		_, err := i.TryInject(reflect.TypeFor[TestB]())
+		if !errors.Is(err, goinject.ErrCircularDependency) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrCircularDependency, err)
+			return
+		}
+
+		if !strings.Contains(err.Error(), "TestB -> TestA -> TestB") {
+			t.Errorf("expected the dependency path in '%v'", err)
+		}
+	})
+
+	t.Run("Self dependency", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterConstructor(func(TestA) TestA { return &TestAImpl{} })
+
+		_, err := i.TryInject(reflect.TypeFor[TestA]())
+		if !errors.Is(err, goinject.ErrCircularDependency) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrCircularDependency, err)
+		}
+	})
+
+	t.Run("Shared dependency isn't a cycle", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+		i.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl]())
+		i.RegisterConstructor(func(TestA, TestC) TestB { return &TestBImpl{} }, goinject.WithLifetime(goinject.Transient))
+		i.RegisterConstructor(func(TestA, TestB, TestB) (TestF, error) { return &TestFImpl{}, nil })
+
+		if _, err := i.TryInject(reflect.TypeFor[TestF]()); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+	})
+}
+
+type TestLevel1 interface{ Level2() TestLevel2 }
+type TestLevel2 interface{ Level3() TestLevel3 }
+type TestLevel3 interface{ MethodTestLevel3() }
+
+type TestLevel1Impl struct {
+	Container goinject.DIContainer `inject:""`
+	level2    TestLevel2
+}
+type TestLevel2Impl struct {
+	Container goinject.DIContainer `inject:""`
+	level3    TestLevel3
+}
+type TestLevel3Impl struct {
+	Initialized bool
+}
+
+func (l *TestLevel1Impl) InitializeDependency() {
+	l.level2 = l.Container.Inject(reflect.TypeFor[TestLevel2]()).(TestLevel2)
+}
+func (l *TestLevel1Impl) Level2() TestLevel2 { return l.level2 }
+func (l *TestLevel2Impl) InitializeDependency() {
+	l.level3 = l.Container.Inject(reflect.TypeFor[TestLevel3]()).(TestLevel3)
+}
+func (l *TestLevel2Impl) Level3() TestLevel3 { return l.level3 }
+func (l *TestLevel3Impl) InitializeDependency() {
+	l.Initialized = true
+}
+func (l *TestLevel3Impl) MethodTestLevel3() {}
+
+type TestContextCycleAImpl struct {
+	Container goinject.DIContainer `inject:""`
+}
+type TestContextCycleBImpl struct {
+	Container goinject.DIContainer `inject:""`
+}
+
+func (c *TestContextCycleAImpl) MethodTestA() {}
+func (c *TestContextCycleAImpl) InitializeDependency(ctx context.Context) error {
+	_, err := c.Container.InjectContext(ctx, reflect.TypeFor[TestB]())
+	return err
+}
+func (c *TestContextCycleBImpl) MethodTestB() {}
+func (c *TestContextCycleBImpl) InitializeDependency(ctx context.Context) error {
+	_, err := c.Container.InjectContext(ctx, reflect.TypeFor[TestA]())
+	return err
+}
+
+type TestPlainCycleAImpl struct {
+	Container goinject.DIContainer `inject:""`
+	B         any
+}
+type TestPlainCycleBImpl struct {
+	Container goinject.DIContainer `inject:""`
+	Err       error
+}
+
+func (c *TestPlainCycleAImpl) MethodTestA() {}
+func (c *TestPlainCycleAImpl) InitializeDependency() {
+	c.B, _ = c.Container.TryInject(reflect.TypeFor[TestB]())
+}
+func (c *TestPlainCycleBImpl) MethodTestB() {}
+func (c *TestPlainCycleBImpl) InitializeDependency() {
+	_, c.Err = c.Container.TryInject(reflect.TypeFor[TestA]())
+}
+
+type TestBarrier struct {
+	sync.WaitGroup
+}
+
+type TestWaitCycleAImpl struct {
+	Container goinject.DIContainer `inject:""`
+	Barrier   *TestBarrier         `inject:""`
+}
+type TestWaitCycleBImpl struct {
+	Container goinject.DIContainer `inject:""`
+	Barrier   *TestBarrier         `inject:""`
+}
+
+func (c *TestWaitCycleAImpl) MethodTestA() {}
+func (c *TestWaitCycleAImpl) InitializeDependency(ctx context.Context) error {
+	c.Barrier.Done()
+	c.Barrier.Wait()
+
+	_, err := c.Container.InjectContext(ctx, reflect.TypeFor[TestB]())
+	return err
+}
+func (c *TestWaitCycleBImpl) MethodTestB() {}
+func (c *TestWaitCycleBImpl) InitializeDependency(ctx context.Context) error {
+	c.Barrier.Done()
+	c.Barrier.Wait()
+
+	_, err := c.Container.InjectContext(ctx, reflect.TypeFor[TestA]())
+	return err
+}
+
+type TestHandOffCycleAImpl struct {
+	Container goinject.DIContainer `inject:""`
+	B         any
+}
+
+func (c *TestHandOffCycleAImpl) MethodTestA() {}
+func (c *TestHandOffCycleAImpl) InitializeDependency() {
+	done := make(chan any)
+
+	go func() {
+		b, _ := c.Container.TryInject(reflect.TypeFor[TestB]())
+		done <- b
+	}()
+
+	c.B = <-done
+}
+
+func TestBaseInjectorReentrant(t *testing.T) {
+	t.Run("Nested injection", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.Register(reflect.TypeFor[goinject.DIContainer](), i)
+		i.RegisterType(reflect.TypeFor[TestLevel1](), reflect.TypeFor[*TestLevel1Impl]())
+		i.RegisterType(reflect.TypeFor[TestLevel2](), reflect.TypeFor[*TestLevel2Impl]())
+		i.RegisterType(reflect.TypeFor[TestLevel3](), reflect.TypeFor[*TestLevel3Impl]())
+
+		done := make(chan error)
+
+		go func() {
+			_, err := i.TryInject(reflect.TypeFor[TestLevel1]())
+			done <- err
+		}()
+
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("unexpected error: '%v'", err)
+				return
+			}
+		case <-time.After(5 * time.Second):
+			t.Error("nested injection deadlocked")
+			return
+		}
+
+		level1 := i.Inject(reflect.TypeFor[TestLevel1]()).(TestLevel1)
+		level3 := level1.Level2().Level3()
+
+		if level3 != i.Inject(reflect.TypeFor[TestLevel3]()) {
+			t.Error("expected nested injection to share the singleton instance")
+		}
+
+		if !level3.(*TestLevel3Impl).Initialized {
+			t.Error("method Initialize was not called")
+		}
+	})
+
+	t.Run("Concurrent singleton built once", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		var calls atomic.Int32
+
+		i.RegisterFactory(reflect.TypeFor[TestC](), func() (any, error) {
+			calls.Add(1)
+			time.Sleep(10 * time.Millisecond)
+			return &TestCImpl{}, nil
+		})
+
+		var wg sync.WaitGroup
+		instances := make([]any, 16)
+
+		for n := range instances {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+				instances[n], _ = i.TryInject(reflect.TypeFor[TestC]())
+			}()
+		}
+
+		wg.Wait()
+
+		if calls.Load() != 1 {
+			t.Errorf("expected factory to be called once, got %d calls", calls.Load())
+		}
+
+		for _, instance := range instances {
+			if instance == nil || instance != instances[0] {
+				t.Error("expected every goroutine to get the same instance")
+				return
+			}
+		}
+	})
+
+	t.Run("Circular dependency through hooks", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.Register(reflect.TypeFor[goinject.DIContainer](), i)
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestContextCycleAImpl]())
+		i.RegisterType(reflect.TypeFor[TestB](), reflect.TypeFor[*TestContextCycleBImpl]())
+
+		_, err := i.TryInject(reflect.TypeFor[TestA]())
+		if !errors.Is(err, goinject.ErrCircularDependency) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrCircularDependency, err)
+			return
+		}
+
+		if !strings.Contains(err.Error(), "TestA -> TestB -> TestA") {
+			t.Errorf("expected the dependency path in '%v'", err)
+		}
+	})
+
+	t.Run("Circular dependency through hooks without context", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.Register(reflect.TypeFor[goinject.DIContainer](), i)
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestPlainCycleAImpl]())
+		i.RegisterType(reflect.TypeFor[TestB](), reflect.TypeFor[*TestPlainCycleBImpl]())
+
+		done := make(chan any)
+
+		go func() {
+			instance, _ := i.TryInject(reflect.TypeFor[TestA]())
+			done <- instance
+		}()
+
+		var testA *TestPlainCycleAImpl
+
+		select {
+		case instance := <-done:
+			testA, _ = instance.(*TestPlainCycleAImpl)
+		case <-time.After(5 * time.Second):
+			t.Error("circular injection deadlocked")
+			return
+		}
+
+		if testA == nil {
+			t.Error("expected the TestA instance")
+			return
+		}
+
+		err := testA.B.(*TestPlainCycleBImpl).Err
+		if !errors.Is(err, goinject.ErrCircularDependency) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrCircularDependency, err)
+			return
+		}
+
+		if !strings.Contains(err.Error(), "TestA -> TestB -> TestA") {
+			t.Errorf("expected the dependency path in '%v'", err)
+		}
+	})
+
+	t.Run("Transient circular dependency through hooks without context", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.Register(reflect.TypeFor[goinject.DIContainer](), i)
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestPlainCycleAImpl](), goinject.WithLifetime(goinject.Transient))
+		i.RegisterType(reflect.TypeFor[TestB](), reflect.TypeFor[*TestPlainCycleBImpl](), goinject.WithLifetime(goinject.Transient))
+
+		testA := i.Inject(reflect.TypeFor[TestA]()).(*TestPlainCycleAImpl)
+
+		err := testA.B.(*TestPlainCycleBImpl).Err
+		if !errors.Is(err, goinject.ErrCircularDependency) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrCircularDependency, err)
+		}
+	})
+
+	t.Run("Circular dependency through constructors", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.Register(reflect.TypeFor[goinject.DIContainer](), i)
+		i.RegisterConstructor(func(c goinject.DIContainer) (TestC, error) {
+			if _, err := c.TryInject(reflect.TypeFor[TestC]()); err != nil {
+				return nil, err
+			}
+
+			return &TestCImpl{}, nil
+		})
+
+		_, err := i.TryInject(reflect.TypeFor[TestC]())
+		if !errors.Is(err, goinject.ErrCircularDependency) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrCircularDependency, err)
+		}
+	})
+
+	t.Run("Circular dependency split between goroutines", func(t *testing.T) {
+		t.Parallel()
+
+		barrier := &TestBarrier{}
+		barrier.Add(2)
+
+		i := goinject.NewBaseContainer()
+		i.Register(reflect.TypeFor[goinject.DIContainer](), i)
+		i.RegisterSelf(barrier)
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestWaitCycleAImpl]())
+		i.RegisterType(reflect.TypeFor[TestB](), reflect.TypeFor[*TestWaitCycleBImpl]())
+
+		done := make(chan error)
+
+		for _, abstractType := range []reflect.Type{reflect.TypeFor[TestA](), reflect.TypeFor[TestB]()} {
+			go func() {
+				_, err := i.TryInject(abstractType)
+				done <- err
+			}()
+		}
+
+		for range 2 {
+			select {
+			case err := <-done:
+				if !errors.Is(err, goinject.ErrCircularDependency) {
+					t.Errorf("expected error '%v', got '%v'", goinject.ErrCircularDependency, err)
+				}
+			case <-time.After(5 * time.Second):
+				t.Error("circular injection deadlocked")
+				return
+			}
+		}
+	})
+
+	t.Run("Circular dependency handed to another goroutine", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.Register(reflect.TypeFor[goinject.DIContainer](), i)
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestHandOffCycleAImpl]())
+		i.RegisterType(reflect.TypeFor[TestB](), reflect.TypeFor[*TestPlainCycleBImpl]())
+
+		done := make(chan any)
+
+		go func() {
+			instance, _ := i.TryInject(reflect.TypeFor[TestA]())
+			done <- instance
+		}()
+
+		var testA *TestHandOffCycleAImpl
+
+		select {
+		case instance := <-done:
+			testA, _ = instance.(*TestHandOffCycleAImpl)
+		case <-time.After(5 * time.Second):
+			t.Error("circular injection deadlocked")
+			return
+		}
+
+		if testA == nil {
+			t.Error("expected the TestA instance")
+			return
+		}
+
+		err := testA.B.(*TestPlainCycleBImpl).Err
+		if !errors.Is(err, goinject.ErrCircularDependency) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrCircularDependency, err)
+			return
+		}
+
+		if !strings.Contains(err.Error(), "TestA -> TestB -> TestA") {
+			t.Errorf("expected the dependency path in '%v'", err)
+		}
+	})
+}
+
+func TestBaseInjectorValidate(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+		i.RegisterFactory(reflect.TypeFor[TestC](), func() (any, error) { return nil, errors.New("not built") })
+		i.RegisterConstructor(NewTestF)
+		i.RegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestFieldsImpl](), goinject.Named("fields"))
+		i.RegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestNamedFieldsImpl](), goinject.Named("named"))
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl](), goinject.Named("replica"))
+
+		if err := i.Validate(); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+	})
+
+	t.Run("Missing dependencies", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterConstructor(NewTestF)
+		i.RegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestNamedFieldsImpl](), goinject.Named("named"))
+
+		err := i.Validate()
+		if !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+			return
+		}
+
+		for _, missing := range []string{"TestA (abstract type), required by TestF", "TestC (abstract type), required by TestF", `TestA "replica" (abstract type), required by TestF "named"`} {
+			if !strings.Contains(err.Error(), missing) {
+				t.Errorf("expected '%s' in '%v'", missing, err)
+			}
+		}
+
+		if strings.Contains(err.Error(), `"backup"`) {
+			t.Errorf("unexpected optional dependency in '%v'", err)
+		}
+	})
+
+	t.Run("Ambiguous dependency", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl](), goinject.Multi())
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestEImpl](), goinject.Multi())
+		i.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl]())
+		i.RegisterConstructor(NewTestF)
+
+		if err := i.Validate(); !errors.Is(err, goinject.ErrAmbiguousDependency) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrAmbiguousDependency, err)
+		}
+	})
+
+	t.Run("Circular dependency", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestCycleAImpl]())
+		i.RegisterType(reflect.TypeFor[TestB](), reflect.TypeFor[*TestCycleBImpl]())
+		i.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCycleCImpl]())
+		i.RegisterConstructor(func(TestD) TestD { return &TestDImpl{} })
+
+		err := i.Validate()
+		if !errors.Is(err, goinject.ErrCircularDependency) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrCircularDependency, err)
+			return
+		}
+
+		for _, path := range []string{"TestA -> TestB -> TestC -> TestA", "TestD -> TestD"} {
+			if !strings.Contains(err.Error(), path) {
+				t.Errorf("expected the dependency path '%s' in '%v'", path, err)
+			}
+		}
+	})
+
+	t.Run("Scope and child container", func(t *testing.T) {
+		t.Parallel()
+
+		parent := goinject.NewBaseContainer()
+		parent.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+
+		child := goinject.NewChildContainer(parent)
+		child.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl](), goinject.WithLifetime(goinject.Scoped))
+
+		scope := child.NewScope()
+		scope.RegisterConstructor(NewTestF)
+
+		if err := scope.Validate(); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+	})
+
+	t.Run("Doesn't build instances", func(t *testing.T) {
+		t.Parallel()
+
+		built := false
+
+		i := goinject.NewBaseContainer()
+		i.RegisterFactory(reflect.TypeFor[TestA](), func() (any, error) { built = true; return &TestAImpl{}, nil })
+		i.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl]())
+		i.RegisterConstructor(NewTestF)
+
+		if err := i.Validate(); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+
+		if built {
+			t.Error("expected no instance to be built")
+		}
+	})
+}
+
+func TestBaseInjectorEager(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		t.Parallel()
+
+		var built []string
+
+		i := goinject.NewBaseContainer()
+		i.RegisterConstructor(func(a TestA, c TestC) TestF { built = append(built, "TestF"); return &TestFImpl{A: a, C: c} }, goinject.Eager())
+		i.RegisterConstructor(func() TestC { built = append(built, "TestC"); return &TestCImpl{} })
+		i.RegisterConstructor(func() TestA { built = append(built, "TestA"); return &TestAImpl{} }, goinject.Eager())
+		i.RegisterConstructor(func() TestB { built = append(built, "TestB"); return &TestBImpl{} })
+
+		if err := i.InstantiateEager(context.Background()); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if strings.Join(built, ",") != "TestA,TestC,TestF" {
+			t.Errorf("expected 'TestA,TestC,TestF' built, got '%v'", built)
+		}
+
+		if err := i.InstantiateEager(context.Background()); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+
+		if len(built) != 3 {
+			t.Errorf("expected eager instances to be built once, got '%v'", built)
+		}
+	})
+
+	t.Run("Failed instantiation", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterConstructor(NewTestF, goinject.Eager())
+
+		err := i.InstantiateEager(context.Background())
+		if !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+			return
+		}
+
+		if !strings.Contains(err.Error(), "eagerly instantiating TestF") {
+			t.Errorf("expected the eager relation in '%v'", err)
+		}
+	})
+
+	t.Run("Canceled context", func(t *testing.T) {
+		t.Parallel()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl](), goinject.Eager())
+
+		if err := i.InstantiateEager(ctx); !errors.Is(err, context.Canceled) {
+			t.Errorf("expected error '%v', got '%v'", context.Canceled, err)
+		}
+	})
+
+	t.Run("Not singleton", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+
+		for _, lifetime := range []goinject.Lifetime{goinject.Transient, goinject.Scoped} {
+			err := i.TryRegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl](), goinject.Eager(), goinject.WithLifetime(lifetime))
+			if !errors.Is(err, goinject.ErrLifetimeNotSupported) {
+				t.Errorf("expected error '%v', got '%v'", goinject.ErrLifetimeNotSupported, err)
+			}
+		}
+	})
+
+	t.Run("Closed container", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.Close()
+
+		if err := i.InstantiateEager(context.Background()); !errors.Is(err, goinject.ErrContainerClosed) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrContainerClosed, err)
+		}
+	})
+}
+
+type TestLazyImpl struct {
+	A       *goinject.Lazy[TestA] `inject:""`
+	Replica *goinject.Lazy[TestA] `inject:"name=replica"`
+}
+type TestLazyCycleAImpl struct {
+	B *goinject.Lazy[TestB] `inject:""`
+}
+type TestLazyCycleBImpl struct {
+	A TestA `inject:""`
+}
+type TestNonInterfaceLazyImpl struct {
+	A *goinject.Lazy[string] `inject:""`
+}
+type TestLazyValueImpl struct {
+	A goinject.Lazy[TestA] `inject:""`
+}
+type TestLazyWrapper struct {
+	*goinject.Lazy[TestA]
+}
+type TestLazyWrapperImpl struct {
+	Wrapper *TestLazyWrapper `inject:""`
+}
+
+func (l *TestLazyImpl) MethodTestF()             {}
+func (l *TestLazyCycleAImpl) MethodTestA()       {}
+func (l *TestLazyCycleBImpl) MethodTestB()       {}
+func (l *TestNonInterfaceLazyImpl) MethodTestF() {}
+func (l *TestLazyValueImpl) MethodTestF()        {}
+func (l *TestLazyWrapperImpl) MethodTestF()      {}
+
+func TestBaseInjectorLazy(t *testing.T) {
+	t.Run("Field", func(t *testing.T) {
+		t.Parallel()
+
+		built := 0
+
+		i := goinject.NewBaseContainer()
+		i.RegisterFactory(reflect.TypeFor[TestA](), func() (any, error) { built++; return &TestAImpl{}, nil })
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestEImpl](), goinject.Named("replica"))
+		i.RegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestLazyImpl]())
+
+		f := i.Inject(reflect.TypeFor[TestF]()).(*TestLazyImpl)
+
+		if built != 0 {
+			t.Error("expected the lazy dependency not to be built on injection")
+			return
+		}
+
+		if f.A.Get() != i.Inject(reflect.TypeFor[TestA]()) {
+			t.Error("expected the lazy handle to get the container instance")
+		}
+
+		if _, ok := f.Replica.Get().(*TestEImpl); !ok {
+			t.Errorf("expected the named instance, got '%v'", f.Replica.Get())
+		}
+
+		if built != 1 {
+			t.Errorf("expected the lazy dependency to be built once, got %d", built)
+		}
+	})
+
+	t.Run("Constructor parameter", func(t *testing.T) {
+		t.Parallel()
+
+		var lazy *goinject.Lazy[TestA]
+
+		i := goinject.NewBaseContainer()
+		i.RegisterConstructor(func(a *goinject.Lazy[TestA]) TestF { lazy = a; return &TestFImpl{} })
+
+		i.Inject(reflect.TypeFor[TestF]())
+
+		if _, err := lazy.TryGet(); !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+			return
+		}
+
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+
+		if _, err := lazy.TryGet(); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+	})
+
+	t.Run("Concurrent get", func(t *testing.T) {
+		t.Parallel()
+
+		var built atomic.Int32
+
+		i := goinject.NewBaseContainer()
+		i.RegisterFactory(reflect.TypeFor[TestA](), func() (any, error) { built.Add(1); return &TestAImpl{}, nil }, goinject.WithLifetime(goinject.Transient))
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl](), goinject.Named("replica"))
+		i.RegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestLazyImpl]())
+
+		f := i.Inject(reflect.TypeFor[TestF]()).(*TestLazyImpl)
+		instances := make([]TestA, 10)
+
+		var wg sync.WaitGroup
+		for n := range instances {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				instances[n] = f.A.Get()
+			}()
+		}
+		wg.Wait()
+
+		if built.Load() != 1 {
+			t.Errorf("expected the lazy dependency to be built once, got %d", built.Load())
+		}
+
+		for _, instance := range instances {
+			if instance != instances[0] {
+				t.Error("expected every goroutine to get the same instance")
+				return
+			}
+		}
+	})
+
+	t.Run("Breaks circular dependency", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestLazyCycleAImpl]())
+		i.RegisterType(reflect.TypeFor[TestB](), reflect.TypeFor[*TestLazyCycleBImpl]())
+
+		if err := i.Validate(); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		a := i.Inject(reflect.TypeFor[TestA]()).(*TestLazyCycleAImpl)
+
+		if a.B.Get().(*TestLazyCycleBImpl).A != a {
+			t.Error("expected the lazy dependency to get the same instance")
+		}
+	})
+
+	t.Run("Not an interface", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+
+		err := i.TryRegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestNonInterfaceLazyImpl]())
+		if !errors.Is(err, goinject.ErrNotAnInterface) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNotAnInterface, err)
+		}
+	})
+
+	t.Run("Not a pointer", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+
+		err := i.TryRegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestLazyValueImpl]())
+		if !errors.Is(err, goinject.ErrFieldNotInjectable) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrFieldNotInjectable, err)
+		}
+
+		// Built by reflection, since the handle can't be copied.
+		fnType := reflect.FuncOf([]reflect.Type{reflect.TypeFor[goinject.Lazy[TestA]]()}, []reflect.Type{reflect.TypeFor[TestF]()}, false)
+		constructor := reflect.MakeFunc(fnType, func([]reflect.Value) []reflect.Value { return nil })
+
+		err = i.TryRegisterConstructor(constructor.Interface())
+		if !errors.Is(err, goinject.ErrFieldNotInjectable) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrFieldNotInjectable, err)
+		}
+	})
+
+	t.Run("Embedded in a struct", func(t *testing.T) {
+		t.Parallel()
+
+		wrapper := &TestLazyWrapper{}
+
+		i := goinject.NewBaseContainer()
+		i.RegisterSelf(wrapper)
+		i.RegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestLazyWrapperImpl]())
+
+		var testF *TestLazyWrapperImpl
+
+		err := recoverPanic(func() {
+			testF = i.Inject(reflect.TypeFor[TestF]()).(*TestLazyWrapperImpl)
+		})
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if testF.Wrapper != wrapper {
+			t.Error("expected the self-binding instance instead of a handle")
+		}
+
+		strict := goinject.NewBaseContainer(goinject.Strict())
+
+		err = strict.TryRegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestLazyWrapperImpl]())
+		if !errors.Is(err, goinject.ErrNotAnInterface) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNotAnInterface, err)
+		}
+	})
+
+	t.Run("Not injected", func(t *testing.T) {
+		t.Parallel()
+
+		var lazy goinject.Lazy[TestA]
+
+		if _, err := lazy.TryGet(); !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+	})
+}
+
+type TestProviderImpl struct {
+	A       goinject.Provider[TestA] `inject:""`
+	C       goinject.Provider[TestC] `inject:""`
+	Replica goinject.Provider[TestA] `inject:"name=replica"`
+}
+
+func (p *TestProviderImpl) MethodTestF() {}
+
+func TestBaseInjectorProvider(t *testing.T) {
+	t.Run("Field", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestEImpl](), goinject.Named("replica"))
+		i.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl](), goinject.WithLifetime(goinject.Transient))
+		i.RegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestProviderImpl]())
+
+		p := i.Inject(reflect.TypeFor[TestF]()).(*TestProviderImpl)
+
+		first, err := p.C()
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		second, _ := p.C()
+		if first == second {
+			t.Error("expected a new transient instance on every call")
+		}
+
+		if replica, _ := p.Replica(); replica != i.InjectNamed(reflect.TypeFor[TestA](), "replica") {
+			t.Errorf("expected the named instance, got '%v'", replica)
+		}
+	})
+
+	t.Run("Bound to scope", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+		i.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl](), goinject.WithLifetime(goinject.Scoped))
+		i.RegisterConstructor(func(c goinject.Provider[TestC]) TestF { return &TestProviderImpl{C: c} }, goinject.WithLifetime(goinject.Scoped))
+
+		scope := i.NewScope()
+		p := scope.Inject(reflect.TypeFor[TestF]()).(*TestProviderImpl)
+
+		c, err := p.C()
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if c != scope.Inject(reflect.TypeFor[TestC]()) {
+			t.Error("expected the provider to inject from the scope it was injected by")
+		}
+
+		if c == i.Inject(reflect.TypeFor[TestC]()) {
+			t.Error("expected the provider not to inject from the container")
+		}
+	})
+
+	t.Run("Missing relation", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestProviderImpl]())
+
+		p := i.Inject(reflect.TypeFor[TestF]()).(*TestProviderImpl)
+
+		if _, err := p.A(); !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+
+		if err := i.Validate(); !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+	})
+}
+
+func TestBaseInjectorOverride(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl]())
+
+		original := i.Inject(reflect.TypeFor[TestC]())
+
+		restore := i.Override(reflect.TypeFor[TestC](), reflect.TypeFor[*TestContextImpl]())
+
+		if _, ok := i.Inject(reflect.TypeFor[TestC]()).(*TestContextImpl); !ok {
+			t.Errorf("expected the overriding instance, got '%v'", i.Inject(reflect.TypeFor[TestC]()))
+		}
+
+		restore()
+
+		if i.Inject(reflect.TypeFor[TestC]()) != original {
+			t.Error("expected the original instance after restored")
+		}
+	})
+
+	t.Run("Instance", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &TestCImpl{}
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl](), goinject.Named("replica"))
+
+		restore := i.OverrideInstance(reflect.TypeFor[TestC](), fake, goinject.Named("replica"))
+		restoreDefault := i.OverrideInstance(reflect.TypeFor[TestC](), fake)
+
+		if i.InjectNamed(reflect.TypeFor[TestC](), "replica") != fake || i.Inject(reflect.TypeFor[TestC]()) != fake {
+			t.Error("expected the overriding instance")
+		}
+
+		restoreDefault()
+		restore()
+
+		if _, err := i.TryInject(reflect.TypeFor[TestC]()); !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+
+		if i.InjectNamed(reflect.TypeFor[TestC](), "replica") == fake {
+			t.Error("expected the original instance after restored")
+		}
+	})
+
+	t.Run("Multi contribution", func(t *testing.T) {
+		t.Parallel()
+
+		first := &TestCImpl{}
+		original := &TestCImpl{}
+		fake := &TestCImpl{}
+
+		i := goinject.NewBaseContainer()
+		i.Register(reflect.TypeFor[TestC](), first, goinject.Multi())
+		i.Register(reflect.TypeFor[TestC](), original, goinject.Multi(), goinject.Named("m"))
+
+		restore := i.OverrideInstance(reflect.TypeFor[TestC](), fake, goinject.Named("m"))
+
+		if i.InjectNamed(reflect.TypeFor[TestC](), "m") != fake {
+			t.Error("expected the overriding instance")
+		}
+
+		if all := i.InjectAll(reflect.TypeFor[TestC]()); len(all) != 2 || all[0] != first || all[1] != fake {
+			t.Errorf("expected the overriding contribution in place of the original one, got '%v'", all)
+		}
+
+		restore()
+
+		if all := i.InjectAll(reflect.TypeFor[TestC]()); len(all) != 2 || all[0] != first || all[1] != original {
+			t.Errorf("expected the original contributions after restored, got '%v'", all)
+		}
+
+		restore = i.OverrideInstance(reflect.TypeFor[TestC](), fake, goinject.Multi(), goinject.Named("other"))
+
+		if all := i.InjectAll(reflect.TypeFor[TestC]()); len(all) != 3 || all[2] != fake {
+			t.Errorf("expected the overriding contribution, got '%v'", all)
+		}
+
+		restore()
+
+		if all := i.InjectAll(reflect.TypeFor[TestC]()); len(all) != 2 {
+			t.Errorf("expected the overriding contribution to be removed, got '%v'", all)
+		}
+
+		_, err := i.TryOverrideInstance(reflect.TypeFor[TestC](), fake, goinject.Multi())
+		if !errors.Is(err, goinject.ErrNotOverridable) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNotOverridable, err)
+		}
+	})
+
+	t.Run("Validates the overriding relation", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestCycleAImpl]())
+
+		restore := i.OverrideInstance(reflect.TypeFor[TestA](), &TestAImpl{})
+
+		if err := i.Validate(); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+
+		restore()
+
+		if err := i.Validate(); !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+
+		if _, err := i.TryOverride(reflect.TypeFor[TestC](), reflect.TypeFor[*TestAImpl]()); !errors.Is(err, goinject.ErrInterfaceNotImplemented) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrInterfaceNotImplemented, err)
+		}
+
+		_, err := i.TryOverrideInstance(reflect.TypeFor[TestC](), &TestCImpl{}, goinject.WithLifetime(goinject.Transient))
+		if !errors.Is(err, goinject.ErrLifetimeNotSupported) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrLifetimeNotSupported, err)
+		}
+	})
+}
+
+func TestBaseInjectorUnregister(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		disposed := []string{}
+		registered := &TestDisposableImpl{Name: "registered", Disposed: &disposed}
+
+		i.Register(reflect.TypeFor[TestDisposable](), registered, goinject.Named("registered"))
+		i.RegisterFactory(reflect.TypeFor[TestDisposable](), func() (any, error) {
+			return &TestDisposableImpl{Name: "built", Disposed: &disposed}, nil
+		})
+		i.RegisterFactory(reflect.TypeFor[TestDisposable](), func() (any, error) {
+			return &TestDisposableImpl{Name: "multi", Disposed: &disposed}, nil
+		}, goinject.Named("multi"), goinject.Multi())
+
+		i.Inject(reflect.TypeFor[TestDisposable]())
+		i.InjectAll(reflect.TypeFor[TestDisposable]())
+
+		for _, name := range []string{"", "registered", "multi"} {
+			if err := i.UnregisterNamed(reflect.TypeFor[TestDisposable](), name); err != nil {
+				t.Errorf("unexpected error: '%v'", err)
+				return
+			}
+		}
+
+		if !reflect.DeepEqual(disposed, []string{"built", "multi"}) {
+			t.Errorf("expected [built multi] to be disposed, got '%v'", disposed)
+		}
+
+		if _, err := i.TryInject(reflect.TypeFor[TestDisposable]()); !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+
+		if all := i.InjectAll(reflect.TypeFor[TestDisposable]()); len(all) != 0 {
+			t.Errorf("expected no instances, got '%v'", all)
+		}
+
+		i.RegisterType(reflect.TypeFor[TestDisposable](), reflect.TypeFor[*TestDisposableImpl](), goinject.Named("registered"))
+
+		if err := i.Close(); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+
+		if len(disposed) != 2 {
+			t.Errorf("expected unregistered instances not to be disposed again, got '%v'", disposed)
+		}
+	})
+
+	t.Run("Not registered", func(t *testing.T) {
+		t.Parallel()
+
+		parent := goinject.NewBaseContainer()
+		parent.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+
+		i := goinject.NewChildContainer(parent)
+
+		if err := i.Unregister(reflect.TypeFor[TestA]()); !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+	})
+
+	t.Run("Nil abstract type", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+
+		if err := i.Unregister(nil); !errors.Is(err, goinject.ErrNotAnInterface) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNotAnInterface, err)
+		}
+	})
+
+	t.Run("Failed disposal", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		disposed := []string{}
+		disposeErr := errors.New("dispose failed")
+
+		i.RegisterFactory(reflect.TypeFor[TestDisposable](), func() (any, error) {
+			return &TestDisposableImpl{Name: "built", Disposed: &disposed, Err: disposeErr}, nil
+		})
+		i.Inject(reflect.TypeFor[TestDisposable]())
+
+		err := i.Unregister(reflect.TypeFor[TestDisposable]())
+		if !errors.Is(err, goinject.ErrDisposeFailed) || !errors.Is(err, disposeErr) {
+			t.Errorf("expected error '%v', got '%v'", disposeErr, err)
+		}
+	})
+}
+
+func TestBaseInjectorReset(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		disposed := []string{}
+
+		newDisposable := func(name string) func() (any, error) {
+			return func() (any, error) {
+				return &TestDisposableImpl{Name: name, Disposed: &disposed}, nil
+			}
+		}
+
+		i.RegisterFactory(reflect.TypeFor[TestDisposable](), newDisposable("first"), goinject.Named("first"))
+		i.RegisterFactory(reflect.TypeFor[TestDisposable](), newDisposable("second"), goinject.Named("second"))
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+
+		i.InjectNamed(reflect.TypeFor[TestDisposable](), "first")
+		i.InjectNamed(reflect.TypeFor[TestDisposable](), "second")
+
+		if err := i.Reset(); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if !reflect.DeepEqual(disposed, []string{"second", "first"}) {
+			t.Errorf("expected [second first] to be disposed, got '%v'", disposed)
+		}
+
+		if _, err := i.TryInject(reflect.TypeFor[TestA]()); !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+
+		if err := i.TryRegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]()); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+	})
+
+	t.Run("Closed container", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.Close()
+
+		if err := i.Reset(); !errors.Is(err, goinject.ErrContainerClosed) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrContainerClosed, err)
+		}
+	})
+}
+
+func TestBaseInjectorRegistrations(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl](), goinject.Eager())
+		i.Register(reflect.TypeFor[TestC](), &TestCImpl{}, goinject.Named("registered"))
+		i.RegisterFactory(reflect.TypeFor[TestB](), func() (any, error) { return &TestBImpl{}, nil }, goinject.WithLifetime(goinject.Scoped))
+		i.RegisterConstructor(NewTestF, goinject.Named("constructor"), goinject.Multi())
+
+		i.Inject(reflect.TypeFor[TestB]())
+
+		expected := []goinject.RegistrationInfo{
+			{AbstractType: reflect.TypeFor[TestA](), ConcreteType: reflect.TypeFor[*TestAImpl](), Eager: true},
+			{AbstractType: reflect.TypeFor[TestC](), ConcreteType: reflect.TypeFor[*TestCImpl](), Name: "registered", Instantiated: true},
+			{AbstractType: reflect.TypeFor[TestB](), ConcreteType: reflect.TypeFor[*TestBImpl](), Lifetime: goinject.Scoped, Instantiated: true},
+			{AbstractType: reflect.TypeFor[TestF](), Name: "constructor", Multi: true},
+		}
+
+		infos := i.Registrations()
+
+		if len(infos) != len(expected) {
+			t.Errorf("expected %d registrations, got '%v'", len(expected), infos)
+			return
+		}
+
+		for n, info := range infos {
+			if !strings.Contains(info.Site, "base_container_test.go:") {
+				t.Errorf("expected the registration site in this file, got '%s'", info.Site)
+			}
+
+			info.Site = ""
+
+			if info != expected[n] {
+				t.Errorf("expected '%+v', got '%+v'", expected[n], info)
+			}
+		}
+	})
+
+	t.Run("Own relations only", func(t *testing.T) {
+		t.Parallel()
+
+		parent := goinject.NewBaseContainer()
+		parent.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+
+		child := goinject.NewChildContainer(parent)
+		child.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestEImpl]())
+
+		scope := child.NewScope()
+
+		if infos := child.Registrations(); len(infos) != 1 || infos[0].ConcreteType != reflect.TypeFor[*TestEImpl]() {
+			t.Errorf("expected the child relation only, got '%v'", infos)
+		}
+
+		if infos := scope.Registrations(); len(infos) != 0 {
+			t.Errorf("expected no registrations, got '%v'", infos)
+		}
+	})
+}
+
+func TestBaseInjectorGraph(t *testing.T) {
+	newContainer := func() *goinject.BaseContainer {
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+		i.RegisterConstructor(NewTestF)
+		i.RegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestLazyImpl](), goinject.Named("lazy"))
+
+		return i
+	}
+
+	t.Run("DOT", func(t *testing.T) {
+		t.Parallel()
+
+		var buf strings.Builder
+		if err := newContainer().Graph().WriteDOT(&buf); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		expected := `digraph goinject {
+	n0 [label="goinject_test.TestA\n*goinject_test.TestAImpl\nsingleton"];
+	n1 [label="goinject_test.TestF\nsingleton"];
+	n2 [label="goinject_test.TestF \"lazy\"\n*goinject_test.TestLazyImpl\nsingleton"];
+	n3 [label="goinject_test.TestC", style=dashed];
+	n4 [label="goinject_test.TestA \"replica\"", style=dashed];
+	n1 -> n0;
+	n1 -> n3;
+	n2 -> n0 [style=dashed];
+	n2 -> n4 [style=dashed];
+}
+`
+
+		if buf.String() != expected {
+			t.Errorf("expected '%s', got '%s'", expected, buf.String())
+		}
+	})
+
+	t.Run("Mermaid", func(t *testing.T) {
+		t.Parallel()
+
+		var buf strings.Builder
+		if err := newContainer().Graph().WriteMermaid(&buf); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		expected := `flowchart LR
+	n0["goinject_test.TestA<br/>*goinject_test.TestAImpl<br/>singleton"]
+	n1["goinject_test.TestF<br/>singleton"]
+	n2["goinject_test.TestF #quot;lazy#quot;<br/>*goinject_test.TestLazyImpl<br/>singleton"]
+	n3("goinject_test.TestC")
+	n4("goinject_test.TestA #quot;replica#quot;")
+	n1 --> n0
+	n1 --> n3
+	n2 -.-> n0
+	n2 -.-> n4
+`
+
+		if buf.String() != expected {
+			t.Errorf("expected '%s', got '%s'", expected, buf.String())
+		}
+	})
+
+	t.Run("JSON", func(t *testing.T) {
+		t.Parallel()
+
+		i := newContainer()
+
+		var buf strings.Builder
+		if err := i.Graph().WriteJSON(&buf); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		var graph goinject.DependencyGraph
+		if err := json.Unmarshal([]byte(buf.String()), &graph); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if !reflect.DeepEqual(&graph, i.Graph()) {
+			t.Errorf("expected '%+v', got '%+v'", i.Graph(), graph)
+		}
+
+		if !strings.Contains(buf.String(), `"external": true`) || !strings.Contains(buf.String(), `"deferred": true`) {
+			t.Errorf("expected external nodes and deferred edges in '%s'", buf.String())
+		}
+	})
+}
+
+func TestBaseInjectorModule(t *testing.T) {
+	shared := goinject.NewModule("shared", func(b goinject.Binder) {
+		goinject.BindType[TestA, *TestAImpl](b)
+	})
+
+	t.Run("Normal execution", func(t *testing.T) {
+		t.Parallel()
+
+		billing := goinject.NewModule("billing", func(b goinject.Binder) {
+			b.Install(shared)
+			goinject.BindFactory[TestC](b, func() (TestC, error) { return &TestCImpl{}, nil })
+			b.RegisterConstructor(NewTestF)
+		})
+		catalog := goinject.NewModule("catalog", func(b goinject.Binder) {
+			b.Install(shared)
+			goinject.BindInstance[TestB](b, &TestBImpl{})
+		})
+		app := goinject.NewModule("app", func(b goinject.Binder) {
+			b.Install(billing, catalog)
+		})
+
+		i := goinject.NewBaseContainer()
+
+		if err := app.Install(i); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if _, err := i.TryInject(reflect.TypeFor[TestF]()); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+
+		modules := []string{}
+		for _, info := range i.Registrations() {
+			modules = append(modules, info.Module)
+		}
+
+		if !reflect.DeepEqual(modules, []string{"shared", "billing", "billing", "catalog"}) {
+			t.Errorf("expected the owning modules, got '%v'", modules)
+		}
+	})
+
+	t.Run("Already registered", func(t *testing.T) {
+		t.Parallel()
+
+		other := goinject.NewModule("other", func(b goinject.Binder) {
+			goinject.BindType[TestA, *TestEImpl](b)
+			goinject.BindFactory[TestC](b, nil)
+		})
+
+		i := goinject.NewBaseContainer()
+		shared.Install(i)
+
+		err := other.Install(i)
+		if !errors.Is(err, goinject.ErrAlreadyRegistered) || !errors.Is(err, goinject.ErrNilFactory) {
+			t.Errorf("expected errors '%v' and '%v', got '%v'", goinject.ErrAlreadyRegistered, goinject.ErrNilFactory, err)
+			return
+		}
+
+		if !strings.Contains(err.Error(), "owned by shared (module), registered by other (module)") {
+			t.Errorf("expected the modules in '%v'", err)
+		}
+	})
+
+	t.Run("Binder used after installed", func(t *testing.T) {
+		t.Parallel()
+
+		var kept goinject.Binder
+
+		module := goinject.NewModule("kept", func(b goinject.Binder) {
+			kept = b
+		})
+
+		i := goinject.NewBaseContainer()
+
+		if err := module.Install(i); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		err := recoverPanic(func() {
+			goinject.BindType[TestA, *TestAImpl](kept)
+		})
+		if !errors.Is(err, goinject.ErrModuleInstalled) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrModuleInstalled, err)
+		}
+
+		err = recoverPanic(func() {
+			kept.Install(shared)
+		})
+		if !errors.Is(err, goinject.ErrModuleInstalled) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrModuleInstalled, err)
+		}
+
+		if i.HasRelation(reflect.TypeFor[TestA](), "") {
+			t.Error("expected the calls after installed not to register anything")
+		}
+	})
+}
+
+type TestConfig struct {
+	DSN string
+}
+
+type TestSelfImpl struct {
+	A TestA `inject:""`
+}
+
+type TestSelfFieldsImpl struct {
+	Cfg      *TestConfig                 `inject:""`
+	Lazy     *goinject.Lazy[*TestConfig] `inject:""`
+	Optional *TestSelfImpl               `inject:"optional"`
+}
+
+func (f *TestSelfFieldsImpl) MethodTestF() {}
+
+func TestBaseInjectorSelf(t *testing.T) {
+	t.Run("Instance", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &TestConfig{DSN: "postgres://"}
+
+		i := goinject.NewBaseContainer()
+		i.RegisterSelf(cfg)
+		i.RegisterSelf(TestConfig{DSN: "value"})
+
+		if i.Inject(reflect.TypeFor[*TestConfig]()) != cfg {
+			t.Error("expected the registered instance")
+		}
+
+		if value := i.Inject(reflect.TypeFor[TestConfig]()).(TestConfig); value.DSN != "value" {
+			t.Errorf("expected the registered value, got '%v'", value)
+		}
+	})
+
+	t.Run("Type", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+		i.RegisterSelfType(reflect.TypeFor[*TestSelfImpl]())
+
+		self := i.Inject(reflect.TypeFor[*TestSelfImpl]()).(*TestSelfImpl)
+
+		if self.A == nil {
+			t.Error("expected the tagged field to be injected")
+		}
+
+		if i.Inject(reflect.TypeFor[*TestSelfImpl]()) != self {
+			t.Error("expected the same instance")
+		}
+	})
+
+	t.Run("Constructor parameter", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &TestConfig{DSN: "postgres://"}
+
+		i := goinject.NewBaseContainer()
+		i.RegisterConstructor(func(cfg *TestConfig) TestC { return &TestContextImpl{Value: cfg} })
+
+		if _, err := i.TryInject(reflect.TypeFor[TestC]()); !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+
+		i.RegisterSelf(cfg, goinject.Named("unused"))
+
+		if err := i.Validate(); !errors.Is(err, goinject.ErrAmbiguousDependency) || !strings.Contains(err.Error(), "*goinject_test.TestConfig (abstract type)") {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrAmbiguousDependency, err)
+		}
+
+		i.RegisterSelf(cfg)
+
+		c, err := i.TryInject(reflect.TypeFor[TestC]())
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if c.(*TestContextImpl).Value != cfg {
+			t.Error("expected the registered instance as parameter")
+		}
+	})
+
+	t.Run("Tagged fields", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &TestConfig{DSN: "postgres://"}
+
+		i := goinject.NewBaseContainer()
+		i.RegisterSelf(cfg)
+		i.RegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestSelfFieldsImpl]())
+
+		f := i.Inject(reflect.TypeFor[TestF]()).(*TestSelfFieldsImpl)
+
+		if f.Cfg != cfg || f.Lazy.Get() != cfg {
+			t.Error("expected the registered instance as field")
+		}
+
+		if f.Optional != nil {
+			t.Error("expected optional field to be nil")
+		}
+
+		strict := goinject.NewBaseContainer(goinject.Strict())
+
+		err := strict.TryRegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestSelfFieldsImpl]())
+		if !errors.Is(err, goinject.ErrNotAnInterface) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNotAnInterface, err)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+
+		testCases := []struct {
+			desc string
+			err  error
+			call func() error
+		}{
+			{"Not a struct", goinject.ErrNotAnInterface, func() error { return i.TryRegisterSelf("string") }},
+			{"Nil instance", goinject.ErrNotAnInterface, func() error { return i.TryRegisterSelf(nil) }},
+			{"Multi", goinject.ErrNotAnInterface, func() error { return i.TryRegisterSelf(&TestConfig{}, goinject.Multi()) }},
+			{"Transient instance", goinject.ErrLifetimeNotSupported, func() error {
+				return i.TryRegisterSelf(&TestConfig{}, goinject.WithLifetime(goinject.Transient))
+			}},
+			{"Struct type", goinject.ErrNotAnStruct, func() error { return i.TryRegisterSelfType(reflect.TypeFor[TestConfig]()) }},
+			{"Already registered", goinject.ErrAlreadyRegistered, func() error {
+				i.RegisterSelf(&TestConfig{}, goinject.Named("registered"))
+				return i.TryRegisterSelf(&TestConfig{}, goinject.Named("registered"))
+			}},
+		}
+
+		for _, tC := range testCases {
+			if err := tC.call(); !errors.Is(err, tC.err) {
+				t.Errorf("%s: expected error '%v', got '%v'", tC.desc, tC.err, err)
+			}
+		}
+
+		_, err := i.TryInject(reflect.TypeFor[*TestConfig]())
+		if !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) || !errors.Is(err, goinject.ErrNotAnInterface) {
+			t.Errorf("expected errors '%v' and '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, goinject.ErrNotAnInterface, err)
+		}
+	})
+
+	t.Run("Strict container", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer(goinject.Strict())
+
+		_, err := i.TryInject(reflect.TypeFor[*TestConfig]())
+		if !errors.Is(err, goinject.ErrNotAnInterface) || errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNotAnInterface, err)
+		}
+
+		if err := i.TryRegisterSelf(&TestConfig{}); !errors.Is(err, goinject.ErrNotAnInterface) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNotAnInterface, err)
+		}
+
+		if err := i.NewScope().TryRegisterSelfType(reflect.TypeFor[*TestSelfImpl]()); !errors.Is(err, goinject.ErrNotAnInterface) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNotAnInterface, err)
+		}
+	})
+
+	t.Run("Module", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &TestConfig{}
+
+		module := goinject.NewModule("config", func(b goinject.Binder) {
+			goinject.BindType[TestA, *TestAImpl](b)
+			goinject.BindSelf(b, cfg)
+			goinject.BindSelfType[*TestSelfImpl](b)
+		})
+
+		i := goinject.NewBaseContainer()
+
+		if err := module.Install(i); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if i.Inject(reflect.TypeFor[*TestConfig]()) != cfg || i.Inject(reflect.TypeFor[*TestSelfImpl]()).(*TestSelfImpl).A == nil {
+			t.Error("expected the self-bindings of the module")
+		}
+
+		for _, info := range i.Registrations() {
+			if info.Module != "config" {
+				t.Errorf("expected the relations owned by the module, got '%+v'", info)
+			}
+		}
+
+		err := module.Install(goinject.NewBaseContainer(goinject.Strict()))
+		if !errors.Is(err, goinject.ErrNotAnInterface) || !strings.Contains(err.Error(), "registered by config (module)") {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNotAnInterface, err)
+		}
+	})
+
+	t.Run("Child container", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &TestConfig{}
+
+		parent := goinject.NewBaseContainer()
+		parent.RegisterSelf(cfg)
+
+		child := goinject.NewChildContainer(parent)
+
+		if child.Inject(reflect.TypeFor[*TestConfig]()) != cfg {
+			t.Error("expected the parent instance")
+		}
+	})
+}
+
+type TestHandler interface {
+	Handle(name string) string
+}
+
+type TestHandlerFunc func(name string) string
+type TestStaticHandler map[string]string
+type TestConstantHandler string
+
+func (f TestHandlerFunc) Handle(name string) string     { return f(name) }
+func (m TestStaticHandler) Handle(name string) string   { return m[name] }
+func (c TestConstantHandler) Handle(name string) string { return string(c) }
+
+func TestBaseInjectorNonStruct(t *testing.T) {
+	t.Run("Instances", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.Register(reflect.TypeFor[TestHandler](), TestHandlerFunc(strings.ToUpper), goinject.Named("func"))
+		i.Register(reflect.TypeFor[TestHandler](), TestStaticHandler{"a": "map"}, goinject.Named("map"))
+		i.Register(reflect.TypeFor[TestHandler](), TestConstantHandler("constant"), goinject.Named("constant"))
+
+		expected := map[string]string{"func": "A", "map": "map", "constant": "constant"}
+
+		for name, result := range expected {
+			h := i.InjectNamed(reflect.TypeFor[TestHandler](), name).(TestHandler)
+
+			if h.Handle("a") != result {
+				t.Errorf("expected '%s', got '%s'", result, h.Handle("a"))
+			}
+		}
+
+		if info := i.Registrations()[0]; info.ConcreteType != reflect.TypeFor[TestHandlerFunc]() {
+			t.Errorf("expected the func concrete type, got '%v'", info.ConcreteType)
+		}
+	})
+
+	t.Run("Factory", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+		i.RegisterFactory(reflect.TypeFor[TestHandler](), func() (any, error) {
+			return TestStaticHandler{"a": "factory"}, nil
+		})
+
+		if h := i.Inject(reflect.TypeFor[TestHandler]()).(TestHandler); h.Handle("a") != "factory" {
+			t.Errorf("expected 'factory', got '%s'", h.Handle("a"))
+		}
+	})
+
+	t.Run("Not instantiable", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+
+		for _, concreteType := range []reflect.Type{reflect.TypeFor[TestHandlerFunc](), reflect.TypeFor[TestStaticHandler](), reflect.TypeFor[TestConstantHandler]()} {
+			if err := i.TryRegisterType(reflect.TypeFor[TestHandler](), concreteType); !errors.Is(err, goinject.ErrNotAnStruct) {
+				t.Errorf("expected error '%v', got '%v'", goinject.ErrNotAnStruct, err)
+			}
+		}
+	})
+
+	t.Run("Not implemented", func(t *testing.T) {
+		t.Parallel()
+
+		i := goinject.NewBaseContainer()
+
+		err := i.TryRegister(reflect.TypeFor[TestHandler](), func(name string) string { return name })
+		if !errors.Is(err, goinject.ErrInterfaceNotImplemented) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrInterfaceNotImplemented, err)
+			return
+		}
+
+		if !strings.Contains(err.Error(), "func(string) string (concrete type)") {
+			t.Errorf("expected the concrete type in '%v'", err)
+		}
+	})
+}
+
 func recoverPanic(f func()) (e error) {
 	defer func() {
 		if r := recover(); r != nil {
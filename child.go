@@ -0,0 +1,117 @@
+package goinject
+
+import (
+	"errors"
+	"reflect"
+)
+
+// NewChildContainer of the parent container.
+//
+// The child container injects its own relations, falling back to the parent
+// container for the ones it doesn't have. [Singleton] instances of the parent
+// relations are built and cached by the parent itself, while [Scoped] and
+// [Transient] ones are built by the container or scope injecting them, like
+// the ones of its own relations. Relations registered into the child
+// container shadow the ones of the parent, instead of failing with
+// [ErrAlreadyRegistered].
+//
+//	billing := goinject.NewChildContainer(base)
+//	billing.RegisterType(reflect.TypeFor[Logger](), reflect.TypeFor[BillingLogger]())
+func NewChildContainer(parent DIContainer, opts ...ContainerOption) *BaseContainer {
+	child := NewBaseContainer(opts...)
+	child.parent = parent
+
+	return child
+}
+
+// parentContainer of the container, or of the outermost one enclosing its
+// scope, returning nil if there's none.
+func (i *BaseContainer) parentContainer() DIContainer {
+	for c := i; c != nil; c = c.enclosing {
+		if c.parent != nil {
+			return c.parent
+		}
+	}
+
+	return nil
+}
+
+// hasRelation reports whether the relation can be injected by the container,
+// either by itself or by its parent.
+//
+// Parent containers that aren't an [IntrospectableContainer] can only tell by
+// injecting the relation, reporting it as missing if it fails with
+// [ErrNoConcreteTypeSupplied].
+func (i *BaseContainer) hasRelation(key bindingKey) bool {
+	i.mx.Lock()
+	b := i.lookup(key)
+	i.mx.Unlock()
+
+	if b != nil {
+		return true
+	}
+
+	switch parent := i.parentContainer().(type) {
+	case nil:
+		return false
+	case IntrospectableContainer:
+		return parent.HasRelation(key.abstractType, key.name)
+	default:
+		_, err := parent.TryInjectNamed(key.abstractType, key.name)
+		return !errors.Is(err, ErrNoConcreteTypeSupplied)
+	}
+}
+
+// lookupInherited the binding of the relation in the parent containers,
+// returning nil if there's none. When a parent container isn't a
+// [BaseContainer], it's returned instead, to inject the relation by itself.
+func (i *BaseContainer) lookupInherited(key bindingKey) (*binding, DIContainer, error) {
+	for parent := i.parentContainer(); parent != nil; {
+		base, ok := parent.(*BaseContainer)
+		if !ok {
+			return nil, parent, nil
+		}
+
+		base.mx.Lock()
+		closed := base.closed
+		b := base.lookup(key)
+		base.mx.Unlock()
+
+		if closed {
+			return nil, nil, ErrContainerClosed
+		}
+
+		if b != nil {
+			return b, nil, nil
+		}
+
+		parent = base.parentContainer()
+	}
+
+	return nil, nil, nil
+}
+
+// lookupAllInherited the multi-bindings of the abstract type in the parent
+// containers, starting from the outermost one. When a parent container isn't
+// a [BaseContainer], it's returned as well, to inject its own multi-bindings
+// before them.
+func (i *BaseContainer) lookupAllInherited(abstractType reflect.Type) ([]*binding, DIContainer, error) {
+	base, ok := i.parentContainer().(*BaseContainer)
+	if !ok {
+		return nil, i.parentContainer(), nil
+	}
+
+	bindings, parent, err := base.lookupAllInherited(abstractType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	base.mx.Lock()
+	defer base.mx.Unlock()
+
+	if base.closed {
+		return nil, nil, ErrContainerClosed
+	}
+
+	return append(bindings, base.lookupAll(abstractType)...), parent, nil
+}
@@ -0,0 +1,198 @@
+package goinject_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	goinject "github.com/d1360-64rc14/go-inject"
+)
+
+func TestChildContainer(t *testing.T) {
+	t.Run("Fallback to parent", func(t *testing.T) {
+		t.Parallel()
+
+		parent := goinject.NewBaseContainer()
+		parent.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl]())
+
+		child := goinject.NewChildContainer(parent)
+
+		var testC TestC
+
+		err := recoverPanic(func() {
+			testC = child.Inject(reflect.TypeFor[TestC]()).(TestC)
+		})
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if testC != parent.Inject(reflect.TypeFor[TestC]()) {
+			t.Error("expected the parent instance")
+		}
+
+		_, err = child.TryInject(reflect.TypeFor[TestA]())
+		if !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+	})
+
+	t.Run("Shadowing", func(t *testing.T) {
+		t.Parallel()
+
+		parent := goinject.NewBaseContainer()
+		parentC := &TestCImpl{}
+		childC := &TestCImpl{}
+
+		parent.Register(reflect.TypeFor[TestC](), parentC)
+
+		child := goinject.NewChildContainer(parent)
+
+		err := recoverPanic(func() {
+			child.Register(reflect.TypeFor[TestC](), childC)
+		})
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if child.Inject(reflect.TypeFor[TestC]()) != childC {
+			t.Error("expected the child instance")
+		}
+
+		if parent.Inject(reflect.TypeFor[TestC]()) != parentC {
+			t.Error("expected the parent to keep its instance")
+		}
+	})
+
+	t.Run("Child relations depending on parent ones", func(t *testing.T) {
+		t.Parallel()
+
+		parent := goinject.NewBaseContainer()
+		parent.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+		parent.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl]())
+
+		child := goinject.NewChildContainer(parent)
+		child.RegisterConstructor(NewTestF)
+
+		testF, err := child.TryInject(reflect.TypeFor[TestF]())
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if testF.(*TestFImpl).C != parent.Inject(reflect.TypeFor[TestC]()) {
+			t.Error("expected constructor parameter to be the parent instance")
+		}
+
+		if _, err := parent.TryInject(reflect.TypeFor[TestF]()); !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+	})
+
+	t.Run("Optional fields", func(t *testing.T) {
+		t.Parallel()
+
+		parent := goinject.NewBaseContainer()
+		parentC := &TestCImpl{}
+		parent.Register(reflect.TypeFor[TestA](), &TestAImpl{})
+		parent.Register(reflect.TypeFor[TestC](), parentC)
+
+		child := goinject.NewChildContainer(parent)
+		child.RegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestFieldsImpl]())
+
+		testF := child.Inject(reflect.TypeFor[TestF]()).(*TestFieldsImpl)
+
+		if testF.C != parentC {
+			t.Error("expected optional field C to be the parent instance")
+		}
+
+		if testF.B != nil {
+			t.Error("expected optional field B to be nil")
+		}
+	})
+
+	t.Run("Optional fields of a minimal parent", func(t *testing.T) {
+		t.Parallel()
+
+		parent := goinject.NewBaseContainer()
+		parentC := &TestCImpl{}
+		parent.Register(reflect.TypeFor[TestA](), &TestAImpl{})
+		parent.Register(reflect.TypeFor[TestC](), parentC)
+
+		child := goinject.NewChildContainer(TestMinimalContainer{parent})
+		child.RegisterType(reflect.TypeFor[TestF](), reflect.TypeFor[*TestFieldsImpl]())
+
+		testF := child.Inject(reflect.TypeFor[TestF]()).(*TestFieldsImpl)
+
+		if testF.C != parentC || testF.B != nil {
+			t.Error("expected optional fields injected by the parent only")
+		}
+	})
+
+	t.Run("InjectAll", func(t *testing.T) {
+		t.Parallel()
+
+		parent := goinject.NewBaseContainer()
+		parentC := &TestCImpl{}
+		childC := &TestCImpl{}
+
+		parent.Register(reflect.TypeFor[TestC](), parentC, goinject.Multi())
+
+		child := goinject.NewChildContainer(parent)
+		child.Register(reflect.TypeFor[TestC](), childC, goinject.Multi())
+
+		all := child.InjectAll(reflect.TypeFor[TestC]())
+		if len(all) != 2 || all[0] != parentC || all[1] != childC {
+			t.Errorf("expected parent and child instances, got '%v'", all)
+		}
+	})
+
+	t.Run("Scoped parent relations", func(t *testing.T) {
+		t.Parallel()
+
+		parent := goinject.NewBaseContainer()
+		parent.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl](), goinject.WithLifetime(goinject.Scoped))
+		parent.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl](), goinject.WithLifetime(goinject.Scoped), goinject.Multi())
+
+		child := goinject.NewChildContainer(parent)
+		first := child.NewScope()
+		second := child.NewScope()
+
+		firstC := first.Inject(reflect.TypeFor[TestC]())
+
+		if first.Inject(reflect.TypeFor[TestC]()) != firstC {
+			t.Error("expected the same instance within the scope")
+		}
+
+		if second.Inject(reflect.TypeFor[TestC]()) == firstC {
+			t.Error("expected a different instance per scope")
+		}
+
+		if parent.Inject(reflect.TypeFor[TestC]()) == firstC {
+			t.Error("expected the parent not to cache the scope instance")
+		}
+
+		if first.InjectAll(reflect.TypeFor[TestC]())[0] == second.InjectAll(reflect.TypeFor[TestC]())[0] {
+			t.Error("expected a different multi-binding instance per scope")
+		}
+	})
+
+	t.Run("Closed parent", func(t *testing.T) {
+		t.Parallel()
+
+		parent := goinject.NewBaseContainer()
+		parent.RegisterType(reflect.TypeFor[TestC](), reflect.TypeFor[*TestCImpl](), goinject.WithLifetime(goinject.Transient))
+
+		child := goinject.NewChildContainer(parent)
+
+		if err := parent.Close(); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+
+		_, err := child.TryInject(reflect.TypeFor[TestC]())
+		if !errors.Is(err, goinject.ErrContainerClosed) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrContainerClosed, err)
+		}
+	})
+}
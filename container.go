@@ -1,43 +1,313 @@
 package goinject
 
 import (
+	"context"
 	"reflect"
 )
 
 // DIContainer declares what must be implemented by the struct type to be
 // used by the global functions.
+//
+// Every registration method accepts [RegistrationOption] values, like
+// [Named], customizing how the relation is registered.
 type DIContainer interface {
 	// RegisterType of an abstract type to a concrete type inside the DI
 	// container, to be injected later.
 	//
 	// The Abstract type must be an interface, and the Concrete type must be a
-	// struct type that implements the interface. Anything different from this
-	// must panic.
-	RegisterType(abstractType reflect.Type, concreteType reflect.Type)
+	// struct type that implements the interface, since it's instantiated with
+	// its zero value. Other types, like func or map ones, must be registered
+	// with a concrete instance or a factory instead. Its fields tagged with
+	// `inject:""` must be exported, and be of an interface type, a *[Lazy]
+	// or [Provider] handle of one, or, unless the container is strict, a
+	// struct or *struct type or a handle of one, injected by its
+	// self-binding. Anything different from this must panic.
+	RegisterType(abstractType reflect.Type, concreteType reflect.Type, opts ...RegistrationOption)
+
+	// TryRegisterType does the same as [DIContainer.RegisterType], but returns
+	// the error instead of panicking.
+	TryRegisterType(abstractType reflect.Type, concreteType reflect.Type, opts ...RegistrationOption) error
 
 	// Register an abstract type to a concrete instance inside the DI
 	// container, to be injected later.
 	//
 	// The Abstract type must be an interface, and the object instance must be
-	// the type of a struct that implements the interface. Anything different
+	// of any type that implements the interface, like a struct, func or map
+	// type. Only the [Singleton] lifetime is supported. Anything different
 	// from this must panic.
-	Register(abstractType reflect.Type, concreteInstance any)
+	Register(abstractType reflect.Type, concreteInstance any, opts ...RegistrationOption)
+
+	// TryRegister does the same as [DIContainer.Register], but returns the
+	// error instead of panicking.
+	TryRegister(abstractType reflect.Type, concreteInstance any, opts ...RegistrationOption) error
+
+	// RegisterFactory of an abstract type to a factory function inside the DI
+	// container, to be called on the first injection.
+	//
+	// The Abstract type must be an interface, and the factory must not be nil.
+	// Anything different from this must panic. The instance returned by the
+	// factory must implement the Abstract type.
+	RegisterFactory(abstractType reflect.Type, factory func() (any, error), opts ...RegistrationOption)
+
+	// TryRegisterFactory does the same as [DIContainer.RegisterFactory], but
+	// returns the error instead of panicking.
+	TryRegisterFactory(abstractType reflect.Type, factory func() (any, error), opts ...RegistrationOption) error
+
+	// RegisterConstructor function inside the DI container, to be called on
+	// the first injection of the abstract type it returns.
+	//
+	// The constructor must be a function returning an Interface and
+	// optionally an error, like func(Logger, BookRepository) (BookService,
+	// error). Its parameters must be of the same types as the tagged fields
+	// of [DIContainer.RegisterType], including the struct or *struct types
+	// injected by their self-bindings unless the container is strict. Each
+	// parameter must be resolved from the DI container before calling it.
+	// Anything different from this must panic on registration.
+	RegisterConstructor(constructor any, opts ...RegistrationOption)
+
+	// TryRegisterConstructor does the same as
+	// [DIContainer.RegisterConstructor], but returns the error instead of
+	// panicking.
+	TryRegisterConstructor(constructor any, opts ...RegistrationOption) error
+
+	// RegisterSelf concrete instance inside the DI container, using its own
+	// struct or *struct type as the abstract type, to be injected later, like
+	// a *Config or *sql.DB without an interface wrapping it. It's injected
+	// for the tagged fields and constructor parameters of the same type.
+	//
+	// Only the [Singleton] lifetime is supported, and the [Multi] option
+	// isn't. Strict containers, created with the [Strict] option, must fail
+	// with [ErrNotAnInterface] instead.
+	RegisterSelf(concreteInstance any, opts ...RegistrationOption)
+
+	// TryRegisterSelf does the same as [DIContainer.RegisterSelf], but
+	// returns the error instead of panicking.
+	TryRegisterSelf(concreteInstance any, opts ...RegistrationOption) error
+
+	// RegisterSelfType does the same as [DIContainer.RegisterSelf], but with
+	// a *struct type to be instantiated the same way as
+	// [DIContainer.RegisterType], supporting every lifetime.
+	RegisterSelfType(concreteType reflect.Type, opts ...RegistrationOption)
+
+	// TryRegisterSelfType does the same as [DIContainer.RegisterSelfType],
+	// but returns the error instead of panicking.
+	TryRegisterSelfType(concreteType reflect.Type, opts ...RegistrationOption) error
 
 	// Inject the instance of the registered Concrete type from the DI container.
 	//
-	// The Abstract type must be an interface.
+	// The Abstract type must be an interface, or a concrete type registered
+	// by [DIContainer.RegisterSelf] or [DIContainer.RegisterSelfType].
+	//
+	// The Concrete type must be instantiated, or built by the registered
+	// factory or constructor, if it isn't already. Relations with the
+	// [Transient] lifetime must be built on every injection instead.
 	//
-	// The Concrete type must be instantiated if it isn't already. The
+	// When instantiated, its fields tagged with `inject:""` must be injected,
+	// and the ones tagged with `inject:"optional"` must be left nil if there's
+	// no concrete type supplied for them. Then, the
 	// [InitializableDependency.InitializeDependency] method must be be called
-	// if the Concrete type implements the [InitializableDependency] interface.
+	// if the Concrete type implements the [InitializableDependency] interface,
+	// or any of its variants, and it wasn't built by a factory or
+	// constructor. If the initialization fails, the instance must not be
+	// cached.
+	//
+	// Relations depending on themselves, directly or transitively, must fail
+	// with [ErrCircularDependency] describing the dependency path.
 	Inject(abstractType reflect.Type) any
+
+	// TryInject does the same as [DIContainer.Inject], but returns the error
+	// instead of panicking.
+	TryInject(abstractType reflect.Type) (any, error)
+
+	// InjectNamed does the same as [DIContainer.Inject], but for the relation
+	// registered with the [Named] option. The empty name injects the default
+	// relation.
+	InjectNamed(abstractType reflect.Type, name string) any
+
+	// TryInjectNamed does the same as [DIContainer.InjectNamed], but returns
+	// the error instead of panicking.
+	TryInjectNamed(abstractType reflect.Type, name string) (any, error)
+
+	// InjectContext does the same as [DIContainer.TryInject], but passing the
+	// context to the [InitializableDependencyWithContext.InitializeDependency]
+	// method of every instance built during the injection.
+	InjectContext(ctx context.Context, abstractType reflect.Type) (any, error)
+
+	// InjectNamedContext does the same as [DIContainer.TryInjectNamed], but
+	// passing the context like [DIContainer.InjectContext].
+	InjectNamedContext(ctx context.Context, abstractType reflect.Type, name string) (any, error)
+
+	// InjectAll the instances of every relation registered with the [Multi]
+	// option for the abstract type, in registration order. Each one of them
+	// must be instantiated the same way as in [DIContainer.Inject].
+	//
+	// The Abstract type must be an interface. An empty slice must be returned
+	// if there's no relation registered with the [Multi] option for it.
+	InjectAll(abstractType reflect.Type) []any
+
+	// TryInjectAll does the same as [DIContainer.InjectAll], but returns the
+	// error instead of panicking.
+	TryInjectAll(abstractType reflect.Type) ([]any, error)
+}
+
+// OverridableContainer declares the optional methods of the DI containers
+// able to override their relations, like the [BaseContainer], used by the
+// [Override] and [OverrideInstance] global functions.
+type OverridableContainer interface {
+	// Override the relation of an abstract type with a concrete type, the
+	// same way as [DIContainer.RegisterType], but replacing the relation
+	// registered with the same name instead of failing with
+	// [ErrAlreadyRegistered]. The instance cached for the replaced relation
+	// isn't injected anymore, while instances already holding it are left
+	// untouched.
+	//
+	// Overriding a named contribution registered with the [Multi] option
+	// must replace it in the ones injected by [DIContainer.InjectAll] too,
+	// while unnamed ones can't be overridden, failing with
+	// [ErrNotOverridable].
+	//
+	// The returned function must restore the replaced relation, along with
+	// its cached instance, or remove the override if there was none.
+	Override(abstractType reflect.Type, concreteType reflect.Type, opts ...RegistrationOption) func()
+
+	// TryOverride does the same as [OverridableContainer.Override], but
+	// returns the error instead of panicking.
+	TryOverride(abstractType reflect.Type, concreteType reflect.Type, opts ...RegistrationOption) (func(), error)
+
+	// OverrideInstance does the same as [OverridableContainer.Override], but
+	// with a concrete instance, the same way as [DIContainer.Register].
+	OverrideInstance(abstractType reflect.Type, concreteInstance any, opts ...RegistrationOption) func()
+
+	// TryOverrideInstance does the same as
+	// [OverridableContainer.OverrideInstance], but returns the error instead
+	// of panicking.
+	TryOverrideInstance(abstractType reflect.Type, concreteInstance any, opts ...RegistrationOption) (func(), error)
+}
+
+// UnregisterableContainer declares the optional methods of the DI containers
+// able to unregister their relations, like the [BaseContainer], used by the
+// [Unregister] and [Reset] global functions.
+type UnregisterableContainer interface {
+	// Unregister the default relation of the abstract type from the DI
+	// container, disposing its instance like [ClosableContainer.Close] if it
+	// was built by the container. Registered concrete instances aren't
+	// disposed.
+	//
+	// It must fail with [ErrNoConcreteTypeSupplied] if there's no relation
+	// registered into the container itself for the abstract type, like the
+	// ones only registered with the [Multi] option or into a parent container.
+	Unregister(abstractType reflect.Type) error
+
+	// UnregisterNamed does the same as [UnregisterableContainer.Unregister],
+	// but for the relation registered with the [Named] option.
+	UnregisterNamed(abstractType reflect.Type, name string) error
+
+	// Reset the DI container, unregistering every relation and disposing the
+	// instances built by it in reverse creation order, like
+	// [ClosableContainer.Close], but keeping the container usable afterwards.
+	Reset() error
+}
+
+// IntrospectableContainer declares the optional methods of the DI containers
+// describing their relations, like the [BaseContainer], used by the
+// [Registrations] and [Graph] global functions.
+type IntrospectableContainer interface {
+	// HasRelation reports whether there's a relation registered for the
+	// abstract type under the given name, without building its instance.
+	// The empty name reports the default relation.
+	HasRelation(abstractType reflect.Type, name string) bool
+
+	// Registrations returns the description of every relation registered
+	// into the DI container itself, in registration order, without the ones of
+	// its parent container.
+	Registrations() []RegistrationInfo
+
+	// Graph of the relations registered into the DI container itself and the
+	// dependencies declared by their constructor parameters and tagged
+	// fields, without building their instances.
+	Graph() *DependencyGraph
+}
+
+// ValidatableContainer declares the optional method of the DI containers able
+// to validate their relations, like the [BaseContainer], used by the
+// [Validate] global function.
+type ValidatableContainer interface {
+	// Validate every relation registered into the DI container, checking that
+	// the dependencies declared by their constructor parameters and tagged
+	// fields can be injected, without building their instances.
+	//
+	// Every missing dependency must be reported with
+	// [ErrNoConcreteTypeSupplied], or with [ErrAmbiguousDependency] when there
+	// are only named or multi relations for it, and every circular one with
+	// [ErrCircularDependency], joined by [errors.Join].
+	Validate() error
+}
+
+// EagerContainer declares the optional method of the DI containers able to
+// build their [Eager] relations, like the [BaseContainer], used by the
+// [InstantiateEager] global function.
+type EagerContainer interface {
+	// InstantiateEager builds every relation registered with the [Eager]
+	// option, in dependency order, passing the context like
+	// [DIContainer.InjectContext]. It must stop at the first failure.
+	InstantiateEager(ctx context.Context) error
+}
+
+// ClosableContainer declares the optional method of the DI containers
+// disposing their instances, like the [BaseContainer], used by the [Close]
+// global function.
+type ClosableContainer interface {
+	// Close the DI container, disposing every instance built by it in
+	// reverse creation order, calling the
+	// [DisposableDependency.DisposeDependency] or the [io.Closer] Close
+	// method of the ones implementing them. Registered concrete instances
+	// and [Transient] ones aren't disposed.
+	//
+	// Every failure must be returned, joined by [errors.Join]. After closed,
+	// the injections must fail with [ErrContainerClosed].
+	Close() error
 }
 
 // InitializableDependency declares the
 // [InitializableDependency.InitializeDependency] contract that can be called
 // during the registration process by the [BaseContainer].
+//
+// The method can inject other dependencies from the DI container injected
+// into its fields, the circular ones failing with [ErrCircularDependency],
+// even from another goroutine. Circular injections made through the
+// container captured otherwise, like by a factory, can't be told apart from
+// concurrent ones, waiting forever instead.
 type InitializableDependency interface {
-	// InitializeDependency after the instance is created, from the
-	// [DIContainer.Inject] method.
+	// InitializeDependency after the instance is created and its tagged
+	// fields are injected, from the [DIContainer.Inject] method.
 	InitializeDependency()
 }
+
+// InitializableDependencyWithError declares the fallible variant of the
+// [InitializableDependency] contract.
+type InitializableDependencyWithError interface {
+	// InitializeDependency after the instance is created and its tagged
+	// fields are injected, from the [DIContainer.Inject] method. If it
+	// returns an error, the injection fails and the instance isn't cached.
+	InitializeDependency() error
+}
+
+// InitializableDependencyWithContext declares the fallible and context-aware
+// variant of the [InitializableDependency] contract.
+type InitializableDependencyWithContext interface {
+	// InitializeDependency after the instance is created and its tagged
+	// fields are injected, receiving the context given to
+	// [DIContainer.InjectContext], or [context.Background] for the other
+	// injection methods. If it returns an error, the injection fails and the
+	// instance isn't cached.
+	InitializeDependency(ctx context.Context) error
+}
+
+// DisposableDependency declares the
+// [DisposableDependency.DisposeDependency] contract that is called when the
+// [BaseContainer] is closed.
+type DisposableDependency interface {
+	// DisposeDependency releasing its resources, from the [ClosableContainer.Close]
+	// method.
+	DisposeDependency() error
+}
@@ -0,0 +1,73 @@
+package goinject
+
+import (
+	"context"
+	"fmt"
+)
+
+// InstantiateEager builds every relation registered into the container with
+// the [Eager] option, in dependency order, so the ones depended on are built
+// first. Relations already built are skipped.
+//
+// It stops at the first failure, returning it wrapped by the abstract type of
+// the eager relation being built.
+//
+//	if err := container.InstantiateEager(ctx); err != nil {
+//		log.Fatal(err)
+//	}
+func (i *BaseContainer) InstantiateEager(ctx context.Context) error {
+	i.mx.Lock()
+
+	if i.closed {
+		i.mx.Unlock()
+		return ErrContainerClosed
+	}
+
+	bindings := append([]*binding(nil), i.bindings...)
+
+	i.mx.Unlock()
+
+	edges, _ := i.dependencyEdges(bindings)
+
+	for _, b := range dependencyOrder(bindings, edges) {
+		if !b.eager {
+			continue
+		}
+
+		if _, err := i.instance(ctx, b); err != nil {
+			return fmt.Errorf("%w, eagerly instantiating %s (abstract type)", err, b.key)
+		}
+	}
+
+	return nil
+}
+
+// dependencyOrder of the bindings, following the edges to the bindings they
+// depend on, so each one comes after its dependencies. Circular dependencies
+// are ordered as they're found.
+func dependencyOrder(bindings []*binding, edges map[*binding][]*binding) []*binding {
+	var ordered []*binding
+
+	seen := make(map[*binding]bool)
+
+	var visit func(b *binding)
+	visit = func(b *binding) {
+		if seen[b] {
+			return
+		}
+
+		seen[b] = true
+
+		for _, dep := range edges[b] {
+			visit(dep)
+		}
+
+		ordered = append(ordered, b)
+	}
+
+	for _, b := range bindings {
+		visit(b)
+	}
+
+	return ordered
+}
@@ -8,4 +8,17 @@ var (
 	ErrInterfaceNotImplemented = errors.New("goinject: concrete type must implement abstract type")
 	ErrAlreadyRegistered       = errors.New("goinject: there's already a relation for abstract type")
 	ErrNoConcreteTypeSupplied  = errors.New("goinject: there's no concrete type supplied for abstract type")
+	ErrNilFactory              = errors.New("goinject: factory function must not be nil")
+	ErrFactoryFailed           = errors.New("goinject: factory failed to build the concrete instance")
+	ErrNotAConstructor         = errors.New("goinject: constructor must be a function returning an Interface and optionally an error")
+	ErrFieldNotInjectable      = errors.New("goinject: tagged field must be exported and have valid inject options")
+	ErrLifetimeNotSupported    = errors.New("goinject: lifetime isn't supported by the registration")
+	ErrContainerClosed         = errors.New("goinject: container is closed")
+	ErrDisposeFailed           = errors.New("goinject: failed to dispose the concrete instance")
+	ErrInitializationFailed    = errors.New("goinject: failed to initialize the concrete instance")
+	ErrCircularDependency      = errors.New("goinject: circular dependency between abstract types")
+	ErrAmbiguousDependency     = errors.New("goinject: there's no default relation for abstract type, only named or multi ones")
+	ErrNotOverridable          = errors.New("goinject: relation can't be overridden, only the default or named ones")
+	ErrModuleInstalled         = errors.New("goinject: module is already installed, its binder can't be used anymore")
+	ErrNotSupported            = errors.New("goinject: operation isn't supported by the container")
 )
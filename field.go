@@ -0,0 +1,87 @@
+package goinject
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// injectTagKey is the struct tag key used to mark fields to be injected.
+const injectTagKey = "inject"
+
+// injectField describes a struct field tagged to be injected by the
+// [BaseContainer] after instantiating the concrete type.
+type injectField struct {
+	index    int
+	name     string
+	optional bool
+}
+
+// injectFields of the struct type, validating their tags.
+//
+// Fields tagged with `inject:""` are required, while the ones tagged with
+// `inject:"optional"` are left untouched when there's no concrete type
+// supplied for them. The `inject:"name=replica"` option injects the relation
+// registered with the [Named] option, and can be combined with the others,
+// like `inject:"name=replica,optional"`.
+//
+// Fields of [Lazy] and [Provider] handles of an interface are also injectable.
+// When selfTypes is set, like for non-strict containers, fields of struct or
+// *struct types and their handles are injectable as well, being injected by
+// their self-bindings.
+func injectFields(structType reflect.Type, selfTypes bool) ([]injectField, error) {
+	var fields []injectField
+
+	for n := range structType.NumField() {
+		field := structType.Field(n)
+
+		tag, ok := field.Tag.Lookup(injectTagKey)
+		if !ok {
+			continue
+		}
+
+		if !field.IsExported() {
+			return nil, fmt.Errorf("%w: %s.%s", ErrFieldNotInjectable, structType.Name(), field.Name)
+		}
+
+		if isHandleValue(field.Type) {
+			return nil, fmt.Errorf("%w: %s.%s: handle must be a pointer", ErrFieldNotInjectable, structType.Name(), field.Name)
+		}
+
+		if !isInjectable(field.Type, selfTypes) {
+			return nil, fmt.Errorf("%w: %s.%s", ErrNotAnInterface, structType.Name(), field.Name)
+		}
+
+		f := injectField{index: n}
+
+		for _, option := range strings.Split(tag, ",") {
+			option = strings.TrimSpace(option)
+
+			switch {
+			case option == "":
+			case option == "optional":
+				f.optional = true
+			case strings.HasPrefix(option, "name="):
+				f.name = strings.TrimPrefix(option, "name=")
+			default:
+				return nil, fmt.Errorf("%w: %s.%s: unknown option %q", ErrFieldNotInjectable, structType.Name(), field.Name, option)
+			}
+		}
+
+		fields = append(fields, f)
+	}
+
+	return fields, nil
+}
+
+// isInjectable reports whether the type can be injected as a field or
+// constructor parameter, being an interface or a [Lazy] or [Provider] handle
+// of one. When selfTypes is set, struct or *struct types and their handles are
+// injectable as well.
+func isInjectable(t reflect.Type, selfTypes bool) bool {
+	if abstractType := handleType(t); abstractType != nil {
+		t = abstractType
+	}
+
+	return t.Kind() == reflect.Interface || selfTypes && isStruct(t)
+}
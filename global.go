@@ -1,11 +1,18 @@
 package goinject
 
 import (
+	"context"
+	"fmt"
 	"reflect"
 )
 
 // DefaultContainer holds the container used when calling the global functions.
 // It can be reassigned to a different container if needed.
+//
+// The global functions using the optional methods of the container, like
+// [Validate] or [Close], fail with [ErrNotSupported] if it doesn't implement
+// the interface declaring them, like [ValidatableContainer], while
+// [Registrations] and [Graph] return nil.
 var DefaultContainer DIContainer = NewBaseContainer()
 
 // RegisterType of an abstract type to a concrete type inside the DI container,
@@ -23,22 +30,48 @@ var DefaultContainer DIContainer = NewBaseContainer()
 //
 //	goinject.RegisterType[BookRepository, MySQLBookRepository]()
 //
-// The Concrete type will be instantiated when injected if it isn't already.
+// The Concrete type will be instantiated when injected if it isn't already,
+// having its exported fields tagged with `inject:""` injected from the DI
+// container. Fields tagged with `inject:"optional"` are left nil if there's no
+// concrete type supplied for them.
+//
+//	type BookService struct {
+//		Repo   BookRepository `inject:""`
+//		Logger Logger         `inject:"optional"`
+//	}
+//
 // If the Concrete type implements the [InitializableDependency] interface, the
 // [InitializableDependency.InitializeDependency] method will be called to
 // instantiate it.
-func RegisterType[Abstract any, Concrete any]() {
-	DefaultContainer.RegisterType(
+//
+// It panics if the registration fails. See [TryRegisterType] for the
+// error-returning variant.
+func RegisterType[Abstract any, Concrete any](opts ...RegistrationOption) {
+	must(TryRegisterType[Abstract, Concrete](opts...))
+}
+
+// TryRegisterType does the same as [RegisterType], but returns the error
+// instead of panicking.
+func TryRegisterType[Abstract any, Concrete any](opts ...RegistrationOption) error {
+	return DefaultContainer.TryRegisterType(
 		reflect.TypeFor[Abstract](),
 		reflect.TypeFor[Concrete](),
+		opts...,
 	)
 }
 
 // Register an abstract type to a concrete instance inside the DI container,
 // to be injected later.
 //
-// The Abstract type must be an interface, and the object instance must be the
-// type of a struct that implements the interface.
+// The Abstract type must be an interface, and the object instance must be of
+// any type that implements the interface, like a struct type or a func
+// adapter.
+//
+//	type HandlerFunc func(ctx context.Context) error
+//
+//	func (f HandlerFunc) Handle(ctx context.Context) error { return f(ctx) }
+//
+//	goinject.Register[Handler](HandlerFunc(handleOrder))
 //
 // Always specify the Abstract type, or a struct type will be inferred,
 // resulting in a panic.
@@ -51,22 +84,369 @@ func RegisterType[Abstract any, Concrete any]() {
 //	bookRepo := repository.NewMySQLBookRepository()
 //
 //	goinject.Register[BookRepository](bookRepo)
-func Register[Abstract any](obj Abstract) {
-	DefaultContainer.Register(
+//
+// It panics if the registration fails. See [TryRegister] for the
+// error-returning variant.
+func Register[Abstract any](obj Abstract, opts ...RegistrationOption) {
+	must(TryRegister(obj, opts...))
+}
+
+// TryRegister does the same as [Register], but returns the error instead of
+// panicking.
+func TryRegister[Abstract any](obj Abstract, opts ...RegistrationOption) error {
+	return DefaultContainer.TryRegister(
 		reflect.TypeFor[Abstract](),
 		obj,
+		opts...,
 	)
 }
 
+// RegisterSelf concrete instance inside the DI container, using its own
+// struct or *struct type as the abstract type, to be injected later.
+//
+//	goinject.RegisterSelf(&Config{DSN: os.Getenv("DSN")})
+//
+//	cfg := goinject.Inject[*Config]()
+//
+// See [DIContainer.RegisterSelf]. It panics if the registration fails. See
+// [TryRegisterSelf] for the error-returning variant.
+func RegisterSelf[Concrete any](obj Concrete, opts ...RegistrationOption) {
+	must(TryRegisterSelf(obj, opts...))
+}
+
+// TryRegisterSelf does the same as [RegisterSelf], but returns the error
+// instead of panicking.
+func TryRegisterSelf[Concrete any](obj Concrete, opts ...RegistrationOption) error {
+	return DefaultContainer.TryRegisterSelf(obj, opts...)
+}
+
+// RegisterSelfType of a *struct type inside the DI container, using it as
+// the abstract type, to be instantiated when injected the same way as
+// [RegisterType].
+//
+//	goinject.RegisterSelfType[*BookService]()
+//
+// See [DIContainer.RegisterSelfType]. It panics if the registration fails.
+// See [TryRegisterSelfType] for the error-returning variant.
+func RegisterSelfType[Concrete any](opts ...RegistrationOption) {
+	must(TryRegisterSelfType[Concrete](opts...))
+}
+
+// TryRegisterSelfType does the same as [RegisterSelfType], but returns the
+// error instead of panicking.
+func TryRegisterSelfType[Concrete any](opts ...RegistrationOption) error {
+	return DefaultContainer.TryRegisterSelfType(reflect.TypeFor[Concrete](), opts...)
+}
+
+// RegisterNamed does the same as [RegisterType], but registers the relation
+// under the given name, so it doesn't conflict with other relations of the
+// same abstract type.
+//
+//	goinject.RegisterNamed[PaymentGateway, StripeGateway]("stripe")
+//	goinject.RegisterNamed[PaymentGateway, PaypalGateway]("paypal")
+func RegisterNamed[Abstract any, Concrete any](name string) {
+	RegisterType[Abstract, Concrete](Named(name))
+}
+
+// TryRegisterNamed does the same as [RegisterNamed], but returns the error
+// instead of panicking.
+func TryRegisterNamed[Abstract any, Concrete any](name string) error {
+	return TryRegisterType[Abstract, Concrete](Named(name))
+}
+
+// RegisterInstanceNamed does the same as [Register], but registers the
+// relation under the given name, so it doesn't conflict with other relations
+// of the same abstract type.
+//
+//	goinject.RegisterInstanceNamed[DB]("replica", replicaDB)
+func RegisterInstanceNamed[Abstract any](name string, obj Abstract) {
+	Register(obj, Named(name))
+}
+
+// TryRegisterInstanceNamed does the same as [RegisterInstanceNamed], but
+// returns the error instead of panicking.
+func TryRegisterInstanceNamed[Abstract any](name string, obj Abstract) error {
+	return TryRegister(obj, Named(name))
+}
+
+// RegisterMulti does the same as [RegisterType], but contributes the Concrete
+// type to the collection of the Abstract type, injected by [InjectAll],
+// instead of registering its single relation.
+//
+//	goinject.RegisterMulti[HealthCheck, DBHealthCheck]()
+//	goinject.RegisterMulti[HealthCheck, CacheHealthCheck]()
+func RegisterMulti[Abstract any, Concrete any](opts ...RegistrationOption) {
+	RegisterType[Abstract, Concrete](append(opts, Multi())...)
+}
+
+// TryRegisterMulti does the same as [RegisterMulti], but returns the error
+// instead of panicking.
+func TryRegisterMulti[Abstract any, Concrete any](opts ...RegistrationOption) error {
+	return TryRegisterType[Abstract, Concrete](append(opts, Multi())...)
+}
+
+// RegisterFactory of an abstract type to a factory function inside the DI
+// container, to be called lazily on the first injection.
+//
+// The Abstract type must be an interface. The instance returned by the factory
+// is cached and returned by the following injections. If the factory returns
+// an error, nothing is cached and the error is returned by the injection.
+//
+//	goinject.RegisterFactory(func() (BookRepository, error) {
+//		return repository.NewMySQLBookRepository(os.Getenv("MYSQL_DSN"))
+//	})
+//
+// It panics if the registration fails. See [TryRegisterFactory] for the
+// error-returning variant.
+func RegisterFactory[Abstract any](factory func() (Abstract, error), opts ...RegistrationOption) {
+	must(TryRegisterFactory(factory, opts...))
+}
+
+// TryRegisterFactory does the same as [RegisterFactory], but returns the error
+// instead of panicking.
+func TryRegisterFactory[Abstract any](factory func() (Abstract, error), opts ...RegistrationOption) error {
+	if factory == nil {
+		return ErrNilFactory
+	}
+
+	return DefaultContainer.TryRegisterFactory(
+		reflect.TypeFor[Abstract](),
+		func() (any, error) {
+			return factory()
+		},
+		opts...,
+	)
+}
+
+// RegisterConstructor function inside the DI container, to be called lazily on
+// the first injection of the abstract type it returns.
+//
+// The constructor must be a function with any number of Interface parameters,
+// returning an Interface and optionally an error. Each parameter is injected
+// from the DI container before calling it, and the returned instance is
+// cached like the ones built by [RegisterFactory].
+//
+//	func NewBookService(logger Logger, repo BookRepository) (BookService, error) {
+//		// ...
+//	}
+//
+//	goinject.RegisterConstructor(NewBookService)
+//
+// It panics if the registration fails. See [TryRegisterConstructor] for the
+// error-returning variant.
+func RegisterConstructor(constructor any, opts ...RegistrationOption) {
+	must(TryRegisterConstructor(constructor, opts...))
+}
+
+// TryRegisterConstructor does the same as [RegisterConstructor], but returns
+// the error instead of panicking.
+func TryRegisterConstructor(constructor any, opts ...RegistrationOption) error {
+	return DefaultContainer.TryRegisterConstructor(constructor, opts...)
+}
+
+// Override the relation of an abstract type with a concrete type inside the DI
+// container, replacing the one already registered, like a fake in tests.
+//
+//	restore := goinject.Override[PaymentGateway, FakePaymentGateway]()
+//	defer restore()
+//
+// It returns the function restoring the replaced relation. See
+// [OverridableContainer.Override].
+//
+// It panics if the registration fails. See [TryOverride] for the
+// error-returning variant.
+func Override[Abstract any, Concrete any](opts ...RegistrationOption) func() {
+	restore, err := TryOverride[Abstract, Concrete](opts...)
+	must(err)
+
+	return restore
+}
+
+// TryOverride does the same as [Override], but returns the error instead of
+// panicking.
+func TryOverride[Abstract any, Concrete any](opts ...RegistrationOption) (func(), error) {
+	c, err := supporting[OverridableContainer]()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.TryOverride(
+		reflect.TypeFor[Abstract](),
+		reflect.TypeFor[Concrete](),
+		opts...,
+	)
+}
+
+// OverrideInstance of an abstract type inside the DI container, replacing the
+// relation already registered, like a fake in tests.
+//
+//	restore := goinject.OverrideInstance[PaymentGateway](&FakePaymentGateway{})
+//	defer restore()
+//
+// It returns the function restoring the replaced relation. See
+// [OverridableContainer.OverrideInstance].
+//
+// It panics if the registration fails. See [TryOverrideInstance] for the
+// error-returning variant.
+func OverrideInstance[Abstract any](obj Abstract, opts ...RegistrationOption) func() {
+	restore, err := TryOverrideInstance(obj, opts...)
+	must(err)
+
+	return restore
+}
+
+// TryOverrideInstance does the same as [OverrideInstance], but returns the
+// error instead of panicking.
+func TryOverrideInstance[Abstract any](obj Abstract, opts ...RegistrationOption) (func(), error) {
+	c, err := supporting[OverridableContainer]()
+	if err != nil {
+		return nil, err
+	}
+
+	return c.TryOverrideInstance(reflect.TypeFor[Abstract](), obj, opts...)
+}
+
+// Unregister the default relation of an abstract type from the DI container,
+// disposing its instance if it was built by the container. See
+// [UnregisterableContainer.Unregister].
+func Unregister[Abstract any]() error {
+	c, err := supporting[UnregisterableContainer]()
+	if err != nil {
+		return err
+	}
+
+	return c.Unregister(reflect.TypeFor[Abstract]())
+}
+
+// UnregisterNamed does the same as [Unregister], but for the relation
+// registered under the given name.
+func UnregisterNamed[Abstract any](name string) error {
+	c, err := supporting[UnregisterableContainer]()
+	if err != nil {
+		return err
+	}
+
+	return c.UnregisterNamed(reflect.TypeFor[Abstract](), name)
+}
+
+// Reset the DI container, unregistering every relation and disposing the
+// instances built by it in reverse creation order. See
+// [UnregisterableContainer.Reset].
+func Reset() error {
+	c, err := supporting[UnregisterableContainer]()
+	if err != nil {
+		return err
+	}
+
+	return c.Reset()
+}
+
+// Install the modules into the DI container, along with the modules installed
+// by them. See [Module.Install].
+//
+//	if err := goinject.Install(billing.Module, catalog.Module); err != nil {
+//		log.Fatal(err)
+//	}
+func Install(modules ...*Module) error {
+	return installModules(DefaultContainer, modules...)
+}
+
 // Inject the instance of some pre-registered Concrete type from the DI container.
 //
 // The Concrete type will be instantiated if it isn't already. Or the
 // [InitializableDependency.InitializeDependency] method will be called if it
 // implements the [InitializableDependency] interface.
 //
-//	var bookRepo BookRepository = goinject.Inject[BookRepository](&bookRepo)
+//	var bookRepo BookRepository = goinject.Inject[BookRepository]()
+//
+// It panics if the injection fails. See [TryInject] for the error-returning
+// variant.
 func Inject[Abstract any]() Abstract {
-	return DefaultContainer.Inject(reflect.TypeFor[Abstract]()).(Abstract)
+	instance, err := TryInject[Abstract]()
+	must(err)
+
+	return instance
+}
+
+// TryInject does the same as [Inject], but returns the error instead of
+// panicking.
+//
+//	bookRepo, err := goinject.TryInject[BookRepository]()
+//	if err != nil {
+//		return err
+//	}
+func TryInject[Abstract any]() (Abstract, error) {
+	return typed[Abstract](DefaultContainer.TryInject(reflect.TypeFor[Abstract]()))
+}
+
+// InjectNamed the instance of the relation registered under the given name.
+// The empty name injects the default relation, like [Inject].
+//
+//	replicaDB := goinject.InjectNamed[DB]("replica")
+//
+// It panics if the injection fails. See [TryInjectNamed] for the
+// error-returning variant.
+func InjectNamed[Abstract any](name string) Abstract {
+	instance, err := TryInjectNamed[Abstract](name)
+	must(err)
+
+	return instance
+}
+
+// TryInjectNamed does the same as [InjectNamed], but returns the error instead
+// of panicking.
+func TryInjectNamed[Abstract any](name string) (Abstract, error) {
+	return typed[Abstract](DefaultContainer.TryInjectNamed(reflect.TypeFor[Abstract](), name))
+}
+
+// InjectContext does the same as [TryInject], but passing the context to the
+// [InitializableDependencyWithContext.InitializeDependency] method of every
+// instance built during the injection.
+//
+//	db, err := goinject.InjectContext[DB](ctx)
+//	if err != nil {
+//		return err
+//	}
+func InjectContext[Abstract any](ctx context.Context) (Abstract, error) {
+	return typed[Abstract](DefaultContainer.InjectContext(ctx, reflect.TypeFor[Abstract]()))
+}
+
+// InjectNamedContext does the same as [TryInjectNamed], but passing the
+// context like [InjectContext].
+func InjectNamedContext[Abstract any](ctx context.Context, name string) (Abstract, error) {
+	return typed[Abstract](DefaultContainer.InjectNamedContext(ctx, reflect.TypeFor[Abstract](), name))
+}
+
+// InjectAll the instances of every Concrete type contributed to the Abstract
+// type collection, in registration order. See [RegisterMulti].
+//
+//	for _, check := range goinject.InjectAll[HealthCheck]() {
+//		check.Run()
+//	}
+//
+// It panics if the injection fails. See [TryInjectAll] for the error-returning
+// variant.
+func InjectAll[Abstract any]() []Abstract {
+	instances, err := TryInjectAll[Abstract]()
+	must(err)
+
+	return instances
+}
+
+// TryInjectAll does the same as [InjectAll], but returns the error instead of
+// panicking.
+func TryInjectAll[Abstract any]() ([]Abstract, error) {
+	instances, err := DefaultContainer.TryInjectAll(reflect.TypeFor[Abstract]())
+	if err != nil {
+		return nil, err
+	}
+
+	typedInstances := make([]Abstract, len(instances))
+	for n, instance := range instances {
+		typedInstances[n] = instance.(Abstract)
+	}
+
+	return typedInstances, nil
 }
 
 // InjectAt the given variable reference the instance of some pre-registered
@@ -79,6 +459,124 @@ func Inject[Abstract any]() Abstract {
 //	var bookRepo BookRepository
 //
 //	goinject.InjectAt(&bookRepo)
+//
+// It panics if the injection fails. See [TryInjectAt] for the error-returning
+// variant.
 func InjectAt[Abstract any](obj *Abstract) {
-	*obj = DefaultContainer.Inject(reflect.TypeFor[Abstract]()).(Abstract)
+	must(TryInjectAt(obj))
+}
+
+// TryInjectAt does the same as [InjectAt], but returns the error instead of
+// panicking. The referenced variable is left untouched on failure.
+func TryInjectAt[Abstract any](obj *Abstract) error {
+	instance, err := TryInject[Abstract]()
+	if err != nil {
+		return err
+	}
+
+	*obj = instance
+
+	return nil
+}
+
+// Registrations returns the description of every relation registered inside
+// the DI container, in registration order, or nil if it isn't an
+// [IntrospectableContainer]. See [IntrospectableContainer.Registrations].
+//
+//	for _, info := range goinject.Registrations() {
+//		fmt.Println(info.AbstractType, info.ConcreteType, info.Site)
+//	}
+func Registrations() []RegistrationInfo {
+	c, ok := DefaultContainer.(IntrospectableContainer)
+	if !ok {
+		return nil
+	}
+
+	return c.Registrations()
+}
+
+// Graph of the relations registered inside the DI container and their
+// dependencies, or nil if it isn't an [IntrospectableContainer]. See
+// [IntrospectableContainer.Graph].
+//
+//	goinject.Graph().WriteDOT(os.Stdout)
+func Graph() *DependencyGraph {
+	c, ok := DefaultContainer.(IntrospectableContainer)
+	if !ok {
+		return nil
+	}
+
+	return c.Graph()
+}
+
+// Validate every relation registered inside the DI container, reporting
+// every missing, ambiguous or circular dependency at once. See
+// [ValidatableContainer.Validate].
+//
+//	func main() {
+//		if err := goinject.Validate(); err != nil {
+//			log.Fatal(err)
+//		}
+//	}
+func Validate() error {
+	c, err := supporting[ValidatableContainer]()
+	if err != nil {
+		return err
+	}
+
+	return c.Validate()
+}
+
+// InstantiateEager builds every relation registered with the [Eager] option
+// inside the DI container, in dependency order. See
+// [EagerContainer.InstantiateEager].
+//
+//	if err := goinject.InstantiateEager(ctx); err != nil {
+//		log.Fatal(err)
+//	}
+//
+//	http.ListenAndServe(":8080", router)
+func InstantiateEager(ctx context.Context) error {
+	c, err := supporting[EagerContainer]()
+	if err != nil {
+		return err
+	}
+
+	return c.InstantiateEager(ctx)
+}
+
+// Close the DI container, disposing every instance built by it in reverse
+// creation order. See [ClosableContainer.Close].
+//
+//	defer goinject.Close()
+func Close() error {
+	c, err := supporting[ClosableContainer]()
+	if err != nil {
+		return err
+	}
+
+	return c.Close()
+}
+
+// typed asserts the injected instance to the Abstract type, returning its zero
+// value if the injection failed.
+func typed[Abstract any](instance any, err error) (Abstract, error) {
+	if err != nil {
+		var zero Abstract
+		return zero, err
+	}
+
+	return instance.(Abstract), nil
+}
+
+// supporting returns the DefaultContainer as the Container interface,
+// declaring its optional methods, failing with [ErrNotSupported] if it doesn't
+// implement it.
+func supporting[Container any]() (Container, error) {
+	c, ok := DefaultContainer.(Container)
+	if !ok {
+		return c, fmt.Errorf("%w: %T (container), %s", ErrNotSupported, DefaultContainer, reflect.TypeFor[Container]().Name())
+	}
+
+	return c, nil
 }
@@ -1,7 +1,9 @@
 package goinject_test
 
 import (
+	"context"
 	"errors"
+	"strings"
 	"testing"
 
 	goinject "github.com/d1360-64rc14/go-inject"
@@ -74,6 +76,14 @@ func TestRegister(t *testing.T) {
 		}
 	})
 
+	t.Run("Func concrete type", func(t *testing.T) {
+		goinject.Register[TestHandler](TestHandlerFunc(strings.ToLower), goinject.Named("func"))
+
+		if h := goinject.InjectNamed[TestHandler]("func"); h.Handle("A") != "a" {
+			t.Errorf("expected 'a', got '%s'", h.Handle("A"))
+		}
+	})
+
 	t.Run("Wrong abstract type", func(t *testing.T) {
 		inst := ""
 
@@ -188,3 +198,387 @@ func TestInjectAt(t *testing.T) {
 		}
 	})
 }
+
+func TestTryInject(t *testing.T) {
+	t.Run("Not registered type", func(t *testing.T) {
+		inst, err := goinject.TryInject[TestD]()
+
+		if !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error: '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+
+		if inst != nil {
+			t.Errorf("expected nil instance, got '%v'", inst)
+		}
+	})
+
+	t.Run("Normal execution", func(t *testing.T) {
+		if err := goinject.TryRegisterType[TestD, *TestDImpl](); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		inst, err := goinject.TryInject[TestD]()
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+
+		if inst == nil {
+			t.Errorf("expected instance, got nil")
+		}
+	})
+
+	t.Run("TryInjectAt", func(t *testing.T) {
+		var inst TestD
+
+		if err := goinject.TryInjectAt(&inst); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+
+		if inst == nil {
+			t.Errorf("expected instance, got nil")
+		}
+	})
+}
+
+func TestRegisterFactory(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		err := recoverPanic(func() {
+			goinject.RegisterFactory(func() (TestB, error) {
+				return &TestBImpl{}, nil
+			})
+		})
+
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+	})
+
+	t.Run("Injected object", func(t *testing.T) {
+		var inst TestB
+
+		err := recoverPanic(func() {
+			inst = goinject.Inject[TestB]()
+		})
+
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+
+		if _, ok := inst.(*TestBImpl); !ok {
+			t.Errorf("expected *TestBImpl, got %T", inst)
+		}
+	})
+
+	t.Run("Already registered", func(t *testing.T) {
+		err := goinject.TryRegisterFactory(func() (TestB, error) {
+			return &TestBImpl{}, nil
+		})
+
+		if !errors.Is(err, goinject.ErrAlreadyRegistered) {
+			t.Errorf("expected error: '%v', got '%v'", goinject.ErrAlreadyRegistered, err)
+		}
+	})
+}
+
+func TestRegisterConstructor(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		err := recoverPanic(func() {
+			goinject.RegisterConstructor(NewTestF)
+		})
+
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+	})
+
+	t.Run("Injected object", func(t *testing.T) {
+		var inst TestF
+
+		err := recoverPanic(func() {
+			inst = goinject.Inject[TestF]()
+		})
+
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+			return
+		}
+
+		if testF, ok := inst.(*TestFImpl); !ok || testF.A == nil || testF.C == nil {
+			t.Errorf("expected *TestFImpl with resolved parameters, got '%v'", inst)
+		}
+	})
+
+	t.Run("Already registered", func(t *testing.T) {
+		err := goinject.TryRegisterConstructor(NewTestF)
+
+		if !errors.Is(err, goinject.ErrAlreadyRegistered) {
+			t.Errorf("expected error: '%v', got '%v'", goinject.ErrAlreadyRegistered, err)
+		}
+	})
+}
+
+func TestNamed(t *testing.T) {
+	replica := &TestAImpl{}
+
+	t.Run("Normal execution", func(t *testing.T) {
+		err := recoverPanic(func() {
+			goinject.RegisterInstanceNamed[TestA]("replica", replica)
+			goinject.RegisterNamed[TestA, *TestEImpl]("other")
+		})
+
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+	})
+
+	t.Run("Already registered", func(t *testing.T) {
+		err := goinject.TryRegisterNamed[TestA, *TestAImpl]("replica")
+
+		if !errors.Is(err, goinject.ErrAlreadyRegistered) {
+			t.Errorf("expected error: '%v', got '%v'", goinject.ErrAlreadyRegistered, err)
+		}
+	})
+
+	t.Run("Injected object", func(t *testing.T) {
+		var inst TestA
+
+		err := recoverPanic(func() {
+			inst = goinject.InjectNamed[TestA]("replica")
+		})
+
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+
+		if inst != replica {
+			t.Errorf("expected the replica instance, got '%v'", inst)
+		}
+
+		if _, ok := goinject.InjectNamed[TestA]("other").(*TestEImpl); !ok {
+			t.Error("expected the other instance to be *TestEImpl")
+		}
+	})
+
+	t.Run("Not registered name", func(t *testing.T) {
+		_, err := goinject.TryInjectNamed[TestA]("backup")
+
+		if !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error: '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+	})
+}
+
+func TestMulti(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		err := recoverPanic(func() {
+			goinject.RegisterMulti[TestE, *TestEImpl]()
+			goinject.RegisterMulti[TestE, *TestEImpl]()
+		})
+
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+	})
+
+	t.Run("Injected objects", func(t *testing.T) {
+		var all []TestE
+
+		err := recoverPanic(func() {
+			all = goinject.InjectAll[TestE]()
+		})
+
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+
+		if len(all) != 2 {
+			t.Errorf("expected 2 instances, got '%v'", all)
+		}
+	})
+
+	t.Run("Single relation isn't registered", func(t *testing.T) {
+		_, err := goinject.TryInject[TestE]()
+
+		if !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error: '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+	})
+}
+
+func TestInjectContext(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		inst, err := goinject.InjectContext[TestA](context.Background())
+
+		if err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+
+		if inst == nil {
+			t.Errorf("expected instance, got nil")
+		}
+	})
+
+	t.Run("Not registered type", func(t *testing.T) {
+		_, err := goinject.InjectNamedContext[TestA](context.Background(), "backup")
+
+		if !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error: '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+	})
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		if err := goinject.Validate(); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+	})
+}
+
+func TestInstantiateEager(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		goinject.RegisterType[TestA, *TestAImpl](goinject.Named("eager"), goinject.Eager())
+
+		if err := goinject.InstantiateEager(context.Background()); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+	})
+}
+
+func TestOverride(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		original := goinject.Inject[TestC]()
+		fake := &TestCImpl{}
+
+		restore := goinject.OverrideInstance[TestC](fake)
+
+		if goinject.Inject[TestC]() != fake {
+			t.Error("expected the overriding instance")
+		}
+
+		restore()
+
+		if goinject.Inject[TestC]() != original {
+			t.Error("expected the original instance after restored")
+		}
+	})
+
+	t.Run("Wrong concrete type", func(t *testing.T) {
+		_, err := goinject.TryOverride[TestC, string]()
+
+		if !errors.Is(err, goinject.ErrNotAnStruct) {
+			t.Errorf("expected error: '%v', got '%v'", goinject.ErrNotAnStruct, err)
+		}
+	})
+}
+
+func TestUnregister(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		goinject.RegisterNamed[TestA, *TestAImpl]("unregistered")
+
+		if err := goinject.UnregisterNamed[TestA]("unregistered"); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+
+		if _, err := goinject.TryInjectNamed[TestA]("unregistered"); !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error: '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+	})
+
+	t.Run("Not registered type", func(t *testing.T) {
+		err := goinject.Unregister[TestE]()
+
+		if !errors.Is(err, goinject.ErrNoConcreteTypeSupplied) {
+			t.Errorf("expected error: '%v', got '%v'", goinject.ErrNoConcreteTypeSupplied, err)
+		}
+	})
+}
+
+func TestRegistrations(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		for _, info := range goinject.Registrations() {
+			if !strings.Contains(info.Site, "global_test.go:") {
+				t.Errorf("expected the registration site in this file, got '%s'", info.Site)
+			}
+		}
+	})
+}
+
+func TestInstall(t *testing.T) {
+	t.Run("Already registered", func(t *testing.T) {
+		module := goinject.NewModule("global", func(b goinject.Binder) {
+			goinject.BindType[TestA, *TestAImpl](b)
+		})
+
+		if err := goinject.Install(module); !errors.Is(err, goinject.ErrAlreadyRegistered) {
+			t.Errorf("expected error: '%v', got '%v'", goinject.ErrAlreadyRegistered, err)
+		}
+	})
+}
+
+func TestRegisterSelf(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		cfg := &TestConfig{DSN: "postgres://"}
+
+		goinject.RegisterSelf(cfg)
+
+		if goinject.Inject[*TestConfig]() != cfg {
+			t.Error("expected the registered instance")
+		}
+	})
+
+	t.Run("Wrong concrete type", func(t *testing.T) {
+		err := goinject.TryRegisterSelfType[TestConfig]()
+
+		if !errors.Is(err, goinject.ErrNotAnStruct) {
+			t.Errorf("expected error: '%v', got '%v'", goinject.ErrNotAnStruct, err)
+		}
+	})
+}
+
+// TestMinimalContainer only implements the methods declared by the
+// DIContainer interface, without the optional ones.
+type TestMinimalContainer struct {
+	goinject.DIContainer
+}
+
+func TestOptionalMethods(t *testing.T) {
+	t.Run("Not supported", func(t *testing.T) {
+		defaultContainer := goinject.DefaultContainer
+		goinject.DefaultContainer = TestMinimalContainer{goinject.NewBaseContainer()}
+
+		defer func() {
+			goinject.DefaultContainer = defaultContainer
+		}()
+
+		testCases := []struct {
+			desc string
+			call func() error
+		}{
+			{"Override", func() error { _, err := goinject.TryOverride[TestA, *TestAImpl](); return err }},
+			{"OverrideInstance", func() error { _, err := goinject.TryOverrideInstance[TestA](&TestAImpl{}); return err }},
+			{"Unregister", goinject.Unregister[TestA]},
+			{"Reset", goinject.Reset},
+			{"Validate", goinject.Validate},
+			{"InstantiateEager", func() error { return goinject.InstantiateEager(context.Background()) }},
+			{"Close", goinject.Close},
+		}
+
+		for _, tC := range testCases {
+			if err := tC.call(); !errors.Is(err, goinject.ErrNotSupported) {
+				t.Errorf("%s: expected error: '%v', got '%v'", tC.desc, goinject.ErrNotSupported, err)
+			}
+		}
+
+		if goinject.Registrations() != nil || goinject.Graph() != nil {
+			t.Error("expected no registrations nor graph")
+		}
+
+		goinject.RegisterType[TestA, *TestAImpl]()
+
+		if _, err := goinject.TryInject[TestA](); err != nil {
+			t.Errorf("unexpected error: '%v'", err)
+		}
+	})
+}
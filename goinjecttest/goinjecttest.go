@@ -0,0 +1,99 @@
+// Package goinjecttest provides isolated DI containers and assertions for
+// tests, so they don't share the state of the goinject.DefaultContainer and
+// can run in parallel.
+//
+//	func TestCheckout(t *testing.T) {
+//		t.Parallel()
+//
+//		c := goinjecttest.New(t)
+//		c.RegisterType(reflect.TypeFor[OrderService](), reflect.TypeFor[*DefaultOrderService]())
+//		goinjecttest.Fake[PaymentGateway](t, c, &FakePaymentGateway{})
+//
+//		service := goinjecttest.AssertResolves[OrderService](t, c)
+//	}
+package goinjecttest
+
+import (
+	"reflect"
+	"testing"
+
+	goinject "github.com/d1360-64rc14/go-inject"
+)
+
+// New DI container for the test, closed when the test and its subtests
+// finish, failing the test if any of its instances fails to be disposed.
+func New(t testing.TB) *goinject.BaseContainer {
+	t.Helper()
+
+	c := goinject.NewBaseContainer()
+
+	t.Cleanup(func() {
+		if err := c.Close(); err != nil {
+			t.Errorf("goinjecttest: failed to close the container: %v", err)
+		}
+	})
+
+	return c
+}
+
+// Fake registers the object instance of the Abstract type inside the DI
+// container, replacing the relation already registered, if any, until the test
+// and its subtests finish.
+//
+// It fails the test immediately if the registration fails, or if the container
+// isn't a [goinject.OverridableContainer].
+func Fake[Abstract any](t testing.TB, c goinject.DIContainer, obj Abstract, opts ...goinject.RegistrationOption) {
+	t.Helper()
+
+	overridable, ok := c.(goinject.OverridableContainer)
+	if !ok {
+		t.Fatalf("goinjecttest: failed to register the fake %T: %v: %T (container)", obj, goinject.ErrNotSupported, c)
+	}
+
+	restore, err := overridable.TryOverrideInstance(reflect.TypeFor[Abstract](), obj, opts...)
+	if err != nil {
+		t.Fatalf("goinjecttest: failed to register the fake %T: %v", obj, err)
+	}
+
+	t.Cleanup(restore)
+}
+
+// AssertRegistered reports whether there's a relation registered for the
+// Abstract type inside the DI container, failing the test if there's none, or
+// if the container isn't a [goinject.IntrospectableContainer]. It doesn't
+// build the instance.
+func AssertRegistered[Abstract any](t testing.TB, c goinject.DIContainer) bool {
+	t.Helper()
+
+	abstractType := reflect.TypeFor[Abstract]()
+
+	introspectable, ok := c.(goinject.IntrospectableContainer)
+	if !ok {
+		t.Errorf("goinjecttest: failed to check the relation of %s: %v: %T (container)", abstractType, goinject.ErrNotSupported, c)
+		return false
+	}
+
+	if !introspectable.HasRelation(abstractType, "") {
+		t.Errorf("goinjecttest: expected a relation registered for %s", abstractType)
+		return false
+	}
+
+	return true
+}
+
+// AssertResolves injects the instance of the Abstract type from the DI
+// container, failing the test and returning its zero value if the injection
+// fails.
+func AssertResolves[Abstract any](t testing.TB, c goinject.DIContainer) Abstract {
+	t.Helper()
+
+	instance, err := c.TryInject(reflect.TypeFor[Abstract]())
+	if err != nil {
+		t.Errorf("goinjecttest: expected %s to be resolved: %v", reflect.TypeFor[Abstract](), err)
+
+		var zero Abstract
+		return zero
+	}
+
+	return instance.(Abstract)
+}
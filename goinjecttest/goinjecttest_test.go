@@ -0,0 +1,162 @@
+package goinjecttest_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	goinject "github.com/d1360-64rc14/go-inject"
+	"github.com/d1360-64rc14/go-inject/goinjecttest"
+)
+
+type TestA interface {
+	MethodTestA()
+}
+
+type TestAImpl struct {
+	Disposed bool
+	Err      error
+}
+
+func (a *TestAImpl) MethodTestA() {}
+func (a *TestAImpl) DisposeDependency() error {
+	a.Disposed = true
+	return a.Err
+}
+
+// recorderTB records the failures of the assertions, instead of failing the
+// test running them.
+type recorderTB struct {
+	testing.TB
+
+	failures int
+	cleanups []func()
+}
+
+func (r *recorderTB) Helper()                           {}
+func (r *recorderTB) Errorf(format string, args ...any) { r.failures++ }
+func (r *recorderTB) Cleanup(f func())                  { r.cleanups = append(r.cleanups, f) }
+
+func (r *recorderTB) finish() {
+	for n := len(r.cleanups) - 1; n >= 0; n-- {
+		r.cleanups[n]()
+	}
+}
+
+func TestNew(t *testing.T) {
+	t.Run("Closed on cleanup", func(t *testing.T) {
+		t.Parallel()
+
+		r := &recorderTB{}
+
+		c := goinjecttest.New(r)
+		c.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+
+		a := c.Inject(reflect.TypeFor[TestA]()).(*TestAImpl)
+
+		r.finish()
+
+		if !a.Disposed {
+			t.Error("expected the instance to be disposed on cleanup")
+		}
+
+		if _, err := c.TryInject(reflect.TypeFor[TestA]()); !errors.Is(err, goinject.ErrContainerClosed) {
+			t.Errorf("expected error '%v', got '%v'", goinject.ErrContainerClosed, err)
+		}
+
+		if r.failures != 0 {
+			t.Errorf("unexpected failures: %d", r.failures)
+		}
+	})
+
+	t.Run("Failed disposal", func(t *testing.T) {
+		t.Parallel()
+
+		r := &recorderTB{}
+
+		c := goinjecttest.New(r)
+		c.RegisterFactory(reflect.TypeFor[TestA](), func() (any, error) { return &TestAImpl{Err: errors.New("busy")}, nil })
+		c.Inject(reflect.TypeFor[TestA]())
+
+		r.finish()
+
+		if r.failures != 1 {
+			t.Errorf("expected 1 failure, got %d", r.failures)
+		}
+	})
+}
+
+func TestFake(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		t.Parallel()
+
+		fake := &TestAImpl{}
+		r := &recorderTB{}
+
+		c := goinjecttest.New(r)
+		c.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+
+		goinjecttest.Fake[TestA](r, c, fake)
+
+		if goinjecttest.AssertResolves[TestA](t, c) != fake {
+			t.Error("expected the fake instance")
+		}
+
+		if site := c.Registrations()[0].Site; !strings.Contains(site, "goinjecttest_test.go:") {
+			t.Errorf("expected the registration site in this file, got '%s'", site)
+		}
+
+		r.finish()
+
+		if fake.Disposed {
+			t.Error("expected the fake instance not to be disposed")
+		}
+	})
+}
+
+func TestAssertRegistered(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		t.Parallel()
+
+		c := goinjecttest.New(t)
+		c.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+
+		if !goinjecttest.AssertRegistered[TestA](t, c) {
+			t.Error("expected the relation to be registered")
+		}
+	})
+
+	t.Run("Not registered", func(t *testing.T) {
+		t.Parallel()
+
+		r := &recorderTB{}
+
+		if goinjecttest.AssertRegistered[TestA](r, goinjecttest.New(t)) || r.failures != 1 {
+			t.Error("expected the assertion to fail")
+		}
+	})
+}
+
+func TestAssertResolves(t *testing.T) {
+	t.Run("Normal execution", func(t *testing.T) {
+		t.Parallel()
+
+		c := goinjecttest.New(t)
+		c.RegisterType(reflect.TypeFor[TestA](), reflect.TypeFor[*TestAImpl]())
+
+		if goinjecttest.AssertResolves[TestA](t, c) == nil {
+			t.Error("expected instance, got nil")
+		}
+	})
+
+	t.Run("Not resolved", func(t *testing.T) {
+		t.Parallel()
+
+		r := &recorderTB{}
+
+		if goinjecttest.AssertResolves[TestA](r, goinjecttest.New(t)) != nil || r.failures != 1 {
+			t.Error("expected the assertion to fail")
+		}
+	})
+}
@@ -0,0 +1,207 @@
+package goinject
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// DependencyGraph of the relations registered inside the DI container and the
+// dependencies declared by their constructor parameters and tagged fields,
+// returned by [IntrospectableContainer.Graph].
+//
+//	var buf bytes.Buffer
+//	container.Graph().WriteMermaid(&buf)
+type DependencyGraph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+// GraphNode of a relation in the [DependencyGraph].
+type GraphNode struct {
+	ID           string `json:"id"`
+	AbstractType string `json:"abstractType"`
+	ConcreteType string `json:"concreteType,omitempty"`
+	Name         string `json:"name,omitempty"`
+	Lifetime     string `json:"lifetime,omitempty"`
+
+	// External nodes are the dependencies without a relation registered into
+	// the container, being injected by its parent container or missing.
+	External bool `json:"external,omitempty"`
+}
+
+// GraphEdge from a relation to a dependency of it in the [DependencyGraph].
+type GraphEdge struct {
+	From     string `json:"from"`
+	To       string `json:"to"`
+	Optional bool   `json:"optional,omitempty"`
+
+	// Deferred edges are declared by [Lazy] and [Provider] handles, only
+	// injected after the relation is built.
+	Deferred bool `json:"deferred,omitempty"`
+}
+
+func (i *BaseContainer) Graph() *DependencyGraph {
+	i.mx.Lock()
+	bindings := append([]*binding(nil), i.bindings...)
+	i.mx.Unlock()
+
+	g := &DependencyGraph{Nodes: []GraphNode{}, Edges: []GraphEdge{}}
+	ids := make(map[*binding]string)
+	externalIDs := make(map[bindingKey]string)
+
+	addNode := func(node GraphNode) string {
+		node.ID = fmt.Sprintf("n%d", len(g.Nodes))
+		g.Nodes = append(g.Nodes, node)
+
+		return node.ID
+	}
+
+	nodeOf := func(b *binding) string {
+		if id, ok := ids[b]; ok {
+			return id
+		}
+
+		b.owner.mx.Lock()
+		concreteType := b.owner.concreteType(b)
+		b.owner.mx.Unlock()
+
+		node := GraphNode{AbstractType: b.key.abstractType.String(), Name: b.key.name, Lifetime: b.lifetime.String()}
+		if concreteType != nil {
+			node.ConcreteType = concreteType.String()
+		}
+
+		ids[b] = addNode(node)
+
+		return ids[b]
+	}
+
+	externalNodeOf := func(key bindingKey) string {
+		if id, ok := externalIDs[key]; ok {
+			return id
+		}
+
+		externalIDs[key] = addNode(GraphNode{AbstractType: key.abstractType.String(), Name: key.name, External: true})
+
+		return externalIDs[key]
+	}
+
+	for _, b := range bindings {
+		nodeOf(b)
+	}
+
+	for _, b := range bindings {
+		for _, dep := range b.dependencies() {
+			i.mx.Lock()
+			resolved := i.lookup(dep.key)
+			i.mx.Unlock()
+
+			edge := GraphEdge{From: ids[b], Optional: dep.optional, Deferred: dep.deferred}
+
+			if resolved != nil {
+				edge.To = nodeOf(resolved)
+			} else {
+				edge.To = externalNodeOf(dep.key)
+			}
+
+			g.Edges = append(g.Edges, edge)
+		}
+	}
+
+	return g
+}
+
+// WriteDOT of the graph to the writer, in the Graphviz DOT language.
+//
+// External nodes are drawn dashed, as well as deferred edges, while optional
+// edges are drawn dotted.
+func (g *DependencyGraph) WriteDOT(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "digraph goinject {")
+
+	for _, node := range g.Nodes {
+		attrs := "label=" + strconv.Quote(node.label("\n"))
+		if node.External {
+			attrs += ", style=dashed"
+		}
+
+		fmt.Fprintf(bw, "\t%s [%s];\n", node.ID, attrs)
+	}
+
+	for _, edge := range g.Edges {
+		switch {
+		case edge.Deferred:
+			fmt.Fprintf(bw, "\t%s -> %s [style=dashed];\n", edge.From, edge.To)
+		case edge.Optional:
+			fmt.Fprintf(bw, "\t%s -> %s [style=dotted];\n", edge.From, edge.To)
+		default:
+			fmt.Fprintf(bw, "\t%s -> %s;\n", edge.From, edge.To)
+		}
+	}
+
+	fmt.Fprintln(bw, "}")
+
+	return bw.Flush()
+}
+
+// WriteMermaid of the graph to the writer, as a Mermaid flowchart.
+//
+// External nodes are drawn with rounded corners, while deferred and optional
+// edges are drawn dotted.
+func (g *DependencyGraph) WriteMermaid(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintln(bw, "flowchart LR")
+
+	for _, node := range g.Nodes {
+		label := strings.ReplaceAll(node.label("<br/>"), `"`, "#quot;")
+
+		if node.External {
+			fmt.Fprintf(bw, "\t%s(\"%s\")\n", node.ID, label)
+		} else {
+			fmt.Fprintf(bw, "\t%s[\"%s\"]\n", node.ID, label)
+		}
+	}
+
+	for _, edge := range g.Edges {
+		if edge.Deferred || edge.Optional {
+			fmt.Fprintf(bw, "\t%s -.-> %s\n", edge.From, edge.To)
+		} else {
+			fmt.Fprintf(bw, "\t%s --> %s\n", edge.From, edge.To)
+		}
+	}
+
+	return bw.Flush()
+}
+
+// WriteJSON of the graph to the writer, encoding its nodes and edges.
+func (g *DependencyGraph) WriteJSON(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(g)
+}
+
+// label of the node, joining its abstract type, concrete type and lifetime
+// with the separator.
+func (n GraphNode) label(separator string) string {
+	lines := []string{n.AbstractType}
+
+	if n.Name != "" {
+		lines[0] += " " + strconv.Quote(n.Name)
+	}
+
+	if n.ConcreteType != "" {
+		lines = append(lines, n.ConcreteType)
+	}
+
+	if n.Lifetime != "" {
+		lines = append(lines, n.Lifetime)
+	}
+
+	return strings.Join(lines, separator)
+}
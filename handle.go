@@ -0,0 +1,58 @@
+package goinject
+
+import "reflect"
+
+// handle is implemented by the types injected as handles to get the instances
+// of their Abstract type later, like [Lazy] and [Provider], instead of the
+// instances themselves.
+type handle interface {
+	// handleType returns the Abstract type of the handle. It must not depend
+	// on the handle value, being called on a zero value.
+	handleType() reflect.Type
+
+	// newHandle of the same type, bound to the relation of the container. It
+	// must not depend on the handle value, being called on a zero value.
+	newHandle(container DIContainer, name string) any
+}
+
+var handleInterfaceType = reflect.TypeFor[handle]()
+
+// handleType returns the Abstract type of the handle type, or nil if it isn't
+// one.
+//
+// Handles are func types or pointer types, like [Provider] and *[Lazy]. Types
+// only implementing the handle interface through an embedded handle, like a
+// struct embedding a *Lazy[Abstract], aren't handles themselves, since they
+// can't be set to the handles it creates.
+func handleType(t reflect.Type) reflect.Type {
+	var h handle
+
+	switch {
+	case !t.Implements(handleInterfaceType):
+		return nil
+	case t.Kind() == reflect.Func:
+		h = reflect.Zero(t).Interface().(handle)
+	case t.Kind() == reflect.Pointer && !t.Elem().Implements(handleInterfaceType):
+		h = reflect.New(t.Elem()).Interface().(handle)
+	default:
+		return nil
+	}
+
+	if reflect.TypeOf(h.newHandle(nil, "")) != t {
+		return nil
+	}
+
+	return h.handleType()
+}
+
+// isHandleValue reports whether the type is the value type of a pointer
+// handle, like Lazy[Abstract] instead of *Lazy[Abstract], which can't be
+// injected since it must not be copied.
+func isHandleValue(t reflect.Type) bool {
+	return t.Kind() != reflect.Pointer && handleType(reflect.PointerTo(t)) != nil
+}
+
+// newHandle of the handle type, bound to the relation of the container.
+func newHandle(t reflect.Type, container DIContainer, name string) reflect.Value {
+	return reflect.ValueOf(reflect.Zero(t).Interface().(handle).newHandle(container, name))
+}
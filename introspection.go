@@ -0,0 +1,114 @@
+package goinject
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// RegistrationInfo describes a relation registered inside the DI container,
+// returned by [IntrospectableContainer.Registrations].
+type RegistrationInfo struct {
+	// AbstractType of the relation.
+	AbstractType reflect.Type
+
+	// ConcreteType of the instances injected for the relation, like
+	// *PostgresDB for the ones registered with the PostgresDB struct type,
+	// or the type of the cached instance for the ones registered with an
+	// instance or built by factories and constructors. It's nil if not known
+	// yet.
+	ConcreteType reflect.Type
+
+	// Name of the relation, empty for the default one.
+	Name string
+
+	// Lifetime of the instances built for the relation.
+	Lifetime Lifetime
+
+	// Multi reports whether the relation was registered with the [Multi]
+	// option.
+	Multi bool
+
+	// Eager reports whether the relation was registered with the [Eager]
+	// option.
+	Eager bool
+
+	// Instantiated reports whether the container holds an instance of the
+	// relation, either registered with it or built by the container.
+	Instantiated bool
+
+	// Module owning the relation, or empty if it wasn't registered by one.
+	Module string
+
+	// Site of the registration in the source code, like "main.go:42", or
+	// empty if not known.
+	Site string
+}
+
+func (i *BaseContainer) Registrations() []RegistrationInfo {
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	infos := make([]RegistrationInfo, len(i.bindings))
+
+	for n, b := range i.bindings {
+		_, instantiated := i.instances[b]
+
+		infos[n] = RegistrationInfo{
+			AbstractType: b.key.abstractType,
+			ConcreteType: i.concreteType(b),
+			Name:         b.key.name,
+			Lifetime:     b.lifetime,
+			Multi:        b.multi,
+			Eager:        b.eager,
+			Instantiated: instantiated,
+			Module:       b.module,
+			Site:         b.site,
+		}
+	}
+
+	return infos
+}
+
+// concreteType of the instances injected for the binding, being the type of
+// its cached instance, or the pointer to the struct type instantiated by the
+// container, returning nil if not known yet. It must be called with the mutex
+// held.
+func (i *BaseContainer) concreteType(b *binding) reflect.Type {
+	if instance, ok := i.instances[b]; ok {
+		return reflect.TypeOf(instance)
+	}
+
+	if b.concreteType != nil {
+		return reflect.PointerTo(b.concreteType)
+	}
+
+	return nil
+}
+
+// packagePath of the goinject package, whose frames are skipped when looking
+// for the registration site.
+var packagePath = reflect.TypeFor[BaseContainer]().PkgPath()
+
+// registrationSite returns the "file:line" of the first caller outside of the
+// goinject and goinjecttest packages, or empty if there's none.
+func registrationSite() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(2, pcs)])
+
+	for {
+		frame, more := frames.Next()
+
+		internal := strings.HasPrefix(frame.Function, packagePath+".") ||
+			strings.HasPrefix(frame.Function, packagePath+"/goinjecttest.")
+
+		if !internal && frame.File != "" {
+			return fmt.Sprintf("%s:%d", frame.File, frame.Line)
+		}
+
+		if !more {
+			return ""
+		}
+	}
+}
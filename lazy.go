@@ -0,0 +1,75 @@
+package goinject
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Lazy handle of the Abstract type, injected by the DI container as a
+// *Lazy[Abstract] field or constructor parameter, without building the
+// instance until its first [Lazy.Get] call.
+//
+//	type ReportService struct {
+//		Renderer *goinject.Lazy[PDFRenderer] `inject:""`
+//	}
+//
+//	func (s *ReportService) Export() {
+//		renderer := s.Renderer.Get()
+//	}
+//
+// The `inject:"name=replica"` option injects a handle of the named relation.
+// It's safe to be used by multiple goroutines.
+type Lazy[Abstract any] struct {
+	container DIContainer
+	name      string
+
+	resolved bool
+	instance Abstract
+	mx       sync.Mutex
+}
+
+// Get the instance from the DI container the handle was injected by, injecting
+// it on the first call.
+//
+// It panics if the injection fails. See [Lazy.TryGet] for the error-returning
+// variant.
+func (l *Lazy[Abstract]) Get() Abstract {
+	instance, err := l.TryGet()
+	must(err)
+
+	return instance
+}
+
+// TryGet does the same as [Lazy.Get], but returns the error instead of
+// panicking. Failed injections aren't cached, being tried again on the next
+// call.
+func (l *Lazy[Abstract]) TryGet() (Abstract, error) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	if l.resolved {
+		return l.instance, nil
+	}
+
+	if l.container == nil {
+		var zero Abstract
+		return zero, ErrNoConcreteTypeSupplied
+	}
+
+	instance, err := typed[Abstract](l.container.TryInjectNamed(reflect.TypeFor[Abstract](), l.name))
+	if err != nil {
+		return instance, err
+	}
+
+	l.instance, l.resolved = instance, true
+
+	return instance, nil
+}
+
+func (*Lazy[Abstract]) handleType() reflect.Type {
+	return reflect.TypeFor[Abstract]()
+}
+
+func (*Lazy[Abstract]) newHandle(container DIContainer, name string) any {
+	return &Lazy[Abstract]{container: container, name: name}
+}
@@ -0,0 +1,38 @@
+package goinject
+
+// Lifetime of the instances built for a relation, set by the [WithLifetime]
+// registration option.
+type Lifetime int
+
+const (
+	// Singleton relations build a single instance, cached and returned by
+	// every injection. It's the default lifetime.
+	Singleton Lifetime = iota
+
+	// Transient relations build a new instance on every injection, which is
+	// never cached by the DI container.
+	Transient
+
+	// Scoped relations build a single instance per scope, created by
+	// [BaseContainer.NewScope]. When injected outside of a scope, the
+	// container itself is the scope, behaving like a [Singleton].
+	Scoped
+)
+
+func (l Lifetime) String() string {
+	switch l {
+	case Singleton:
+		return "singleton"
+	case Transient:
+		return "transient"
+	case Scoped:
+		return "scoped"
+	default:
+		return "unknown"
+	}
+}
+
+// isValid reports whether the lifetime is one of the declared ones.
+func (l Lifetime) isValid() bool {
+	return l >= Singleton && l <= Scoped
+}
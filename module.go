@@ -0,0 +1,215 @@
+package goinject
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Module groups related registrations, like the ones of a subsystem, to be
+// installed into any [DIContainer] at once.
+//
+//	var Billing = goinject.NewModule("billing", func(b goinject.Binder) {
+//		b.Install(Logging)
+//		goinject.BindType[InvoiceRepository, SQLInvoiceRepository](b)
+//		b.RegisterConstructor(NewBillingService)
+//	})
+//
+//	if err := Billing.Install(container); err != nil {
+//		log.Fatal(err)
+//	}
+//
+// Relations registered by a module are owned by it, being reported by
+// [ErrAlreadyRegistered] errors and by [IntrospectableContainer.Registrations].
+type Module struct {
+	name      string
+	configure func(b Binder)
+}
+
+// NewModule with the given name, registering its relations with the configure
+// function when installed.
+func NewModule(name string, configure func(b Binder)) *Module {
+	return &Module{name: name, configure: configure}
+}
+
+// Name of the module.
+func (m *Module) Name() string {
+	return m.name
+}
+
+// Install the module into the DI container, along with the modules installed
+// by it. Modules installed more than once, like a module shared by others,
+// are only installed the first time.
+//
+// Every registration failure is returned, joined by [errors.Join], annotated
+// with the module registering it.
+func (m *Module) Install(c DIContainer) error {
+	return installModules(c, m)
+}
+
+// installModules into the DI container, like [Module.Install], installing
+// the ones shared by them only once.
+func installModules(c DIContainer, modules ...*Module) error {
+	b := &binder{container: c, installed: make(map[*Module]bool)}
+	b.Install(modules...)
+	b.done = true
+
+	return errors.Join(b.errs...)
+}
+
+// Binder registers the relations of a [Module] into the DI container it's
+// being installed into. Registration failures are reported by
+// [Module.Install], instead of panicking.
+//
+// The binder must not be kept for later, since its calls made after the
+// module is installed panic with [ErrModuleInstalled].
+type Binder interface {
+	// RegisterType does the same as [DIContainer.RegisterType].
+	RegisterType(abstractType reflect.Type, concreteType reflect.Type, opts ...RegistrationOption)
+
+	// Register does the same as [DIContainer.Register].
+	Register(abstractType reflect.Type, concreteInstance any, opts ...RegistrationOption)
+
+	// RegisterFactory does the same as [DIContainer.RegisterFactory].
+	RegisterFactory(abstractType reflect.Type, factory func() (any, error), opts ...RegistrationOption)
+
+	// RegisterConstructor does the same as [DIContainer.RegisterConstructor].
+	RegisterConstructor(constructor any, opts ...RegistrationOption)
+
+	// RegisterSelf does the same as [DIContainer.RegisterSelf].
+	RegisterSelf(concreteInstance any, opts ...RegistrationOption)
+
+	// RegisterSelfType does the same as [DIContainer.RegisterSelfType].
+	RegisterSelfType(concreteType reflect.Type, opts ...RegistrationOption)
+
+	// Install the modules as part of the module being installed.
+	Install(modules ...*Module)
+}
+
+// BindType does the same as [RegisterType], but into the container the module
+// of the binder is being installed into.
+func BindType[Abstract any, Concrete any](b Binder, opts ...RegistrationOption) {
+	b.RegisterType(reflect.TypeFor[Abstract](), reflect.TypeFor[Concrete](), opts...)
+}
+
+// BindInstance does the same as [Register], but into the container the module
+// of the binder is being installed into.
+func BindInstance[Abstract any](b Binder, obj Abstract, opts ...RegistrationOption) {
+	b.Register(reflect.TypeFor[Abstract](), obj, opts...)
+}
+
+// BindFactory does the same as [RegisterFactory], but into the container the
+// module of the binder is being installed into.
+func BindFactory[Abstract any](b Binder, factory func() (Abstract, error), opts ...RegistrationOption) {
+	if factory == nil {
+		b.RegisterFactory(reflect.TypeFor[Abstract](), nil, opts...)
+		return
+	}
+
+	b.RegisterFactory(reflect.TypeFor[Abstract](), func() (any, error) {
+		return factory()
+	}, opts...)
+}
+
+// BindSelf does the same as [RegisterSelf], but into the container the module
+// of the binder is being installed into.
+func BindSelf[Concrete any](b Binder, obj Concrete, opts ...RegistrationOption) {
+	b.RegisterSelf(obj, opts...)
+}
+
+// BindSelfType does the same as [RegisterSelfType], but into the container the
+// module of the binder is being installed into.
+func BindSelfType[Concrete any](b Binder, opts ...RegistrationOption) {
+	b.RegisterSelfType(reflect.TypeFor[Concrete](), opts...)
+}
+
+// binder of the modules being installed into the container, collecting the
+// registration failures.
+type binder struct {
+	container DIContainer
+	module    *Module
+	installed map[*Module]bool
+	errs      []error
+	done      bool
+}
+
+func (b *binder) RegisterType(abstractType reflect.Type, concreteType reflect.Type, opts ...RegistrationOption) {
+	b.mustBeInstalling()
+
+	b.check(b.container.TryRegisterType(abstractType, concreteType, b.options(opts)...))
+}
+
+func (b *binder) Register(abstractType reflect.Type, concreteInstance any, opts ...RegistrationOption) {
+	b.mustBeInstalling()
+
+	b.check(b.container.TryRegister(abstractType, concreteInstance, b.options(opts)...))
+}
+
+func (b *binder) RegisterFactory(abstractType reflect.Type, factory func() (any, error), opts ...RegistrationOption) {
+	b.mustBeInstalling()
+
+	b.check(b.container.TryRegisterFactory(abstractType, factory, b.options(opts)...))
+}
+
+func (b *binder) RegisterConstructor(constructor any, opts ...RegistrationOption) {
+	b.mustBeInstalling()
+
+	b.check(b.container.TryRegisterConstructor(constructor, b.options(opts)...))
+}
+
+func (b *binder) RegisterSelf(concreteInstance any, opts ...RegistrationOption) {
+	b.mustBeInstalling()
+
+	b.check(b.container.TryRegisterSelf(concreteInstance, b.options(opts)...))
+}
+
+func (b *binder) RegisterSelfType(concreteType reflect.Type, opts ...RegistrationOption) {
+	b.mustBeInstalling()
+
+	b.check(b.container.TryRegisterSelfType(concreteType, b.options(opts)...))
+}
+
+func (b *binder) Install(modules ...*Module) {
+	b.mustBeInstalling()
+
+	for _, m := range modules {
+		if b.installed[m] {
+			continue
+		}
+
+		b.installed[m] = true
+
+		parent := b.module
+		b.module = m
+		m.configure(b)
+		b.module = parent
+	}
+}
+
+// mustBeInstalling panics with [ErrModuleInstalled] if the modules are
+// already installed, since their registrations couldn't be reported anymore.
+func (b *binder) mustBeInstalling() {
+	if b.done {
+		panic(ErrModuleInstalled)
+	}
+}
+
+// options of the registration, owned by the module being installed.
+func (b *binder) options(opts []RegistrationOption) []RegistrationOption {
+	return append(opts[:len(opts):len(opts)], ownedBy(b.module.name))
+}
+
+// check the registration failure, annotating it with the module being
+// installed.
+func (b *binder) check(err error) {
+	if err != nil {
+		b.errs = append(b.errs, fmt.Errorf("%w, registered by %s (module)", err, b.module.name))
+	}
+}
+
+// ownedBy registers the relation as owned by the module.
+func ownedBy(module string) RegistrationOption {
+	return func(r *registration) {
+		r.module = module
+	}
+}
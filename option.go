@@ -0,0 +1,94 @@
+package goinject
+
+// RegistrationOption customizes how a relation is registered inside the DI
+// container.
+type RegistrationOption func(*registration)
+
+// registration holds the options applied to a relation being registered.
+type registration struct {
+	name     string
+	multi    bool
+	lifetime Lifetime
+	eager    bool
+	module   string
+}
+
+// Named registers the relation under the given name, so multiple relations
+// can be registered for the same abstract type side by side.
+//
+//	goinject.RegisterType[DB, PostgresDB](goinject.Named("replica"))
+//
+// Named relations are only injected when asked by name, while the relations
+// registered without a name are the default ones.
+func Named(name string) RegistrationOption {
+	return func(r *registration) {
+		r.name = name
+	}
+}
+
+// Multi registers the relation as one more contribution to the abstract type
+// collection, instead of its single relation, so it doesn't conflict with
+// other relations of the same abstract type. Every contribution is injected by
+// [DIContainer.InjectAll], in registration order.
+//
+//	goinject.RegisterType[HealthCheck, DBHealthCheck](goinject.Multi())
+//	goinject.RegisterType[HealthCheck, CacheHealthCheck](goinject.Multi())
+//
+// When combined with the [Named] option, the contribution can also be injected
+// by its name.
+func Multi() RegistrationOption {
+	return func(r *registration) {
+		r.multi = true
+	}
+}
+
+// WithLifetime registers the relation with the given [Lifetime], instead of
+// the default [Singleton] one.
+//
+//	goinject.RegisterType[RequestBuilder, HTTPRequestBuilder](goinject.WithLifetime(goinject.Transient))
+//
+// Relations registered with a concrete instance only support the [Singleton]
+// lifetime.
+func WithLifetime(lifetime Lifetime) RegistrationOption {
+	return func(r *registration) {
+		r.lifetime = lifetime
+	}
+}
+
+// Eager registers the [Singleton] relation to be built by
+// [EagerContainer.InstantiateEager] at startup, instead of on its first
+// injection.
+//
+//	goinject.RegisterType[ConnectionPool, PostgresPool](goinject.Eager())
+//
+// Relations with any other lifetime can't be eager.
+func Eager() RegistrationOption {
+	return func(r *registration) {
+		r.eager = true
+	}
+}
+
+// ContainerOption customizes the [BaseContainer] being created.
+type ContainerOption func(*BaseContainer)
+
+// Strict creates the container accepting only interfaces as abstract types,
+// failing the self-bindings, like [DIContainer.RegisterSelf], with
+// [ErrNotAnInterface]. Its scopes are strict as well.
+//
+//	goinject.DefaultContainer = goinject.NewBaseContainer(goinject.Strict())
+func Strict() ContainerOption {
+	return func(i *BaseContainer) {
+		i.strict = true
+	}
+}
+
+// newRegistration applying each one of the options.
+func newRegistration(opts []RegistrationOption) registration {
+	var r registration
+
+	for _, opt := range opts {
+		opt(&r)
+	}
+
+	return r
+}
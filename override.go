@@ -0,0 +1,173 @@
+package goinject
+
+import (
+	"fmt"
+	"reflect"
+)
+
+func (i *BaseContainer) Override(abstractType reflect.Type, concreteType reflect.Type, opts ...RegistrationOption) func() {
+	restore, err := i.TryOverride(abstractType, concreteType, opts...)
+	must(err)
+
+	return restore
+}
+
+func (i *BaseContainer) TryOverride(abstractType reflect.Type, concreteType reflect.Type, opts ...RegistrationOption) (func(), error) {
+	if err := checkRelation(abstractType, concreteType); err != nil {
+		return nil, err
+	}
+
+	if concreteType.Kind() == reflect.Pointer {
+		concreteType = concreteType.Elem()
+	}
+
+	fields, err := injectFields(concreteType, !i.strict)
+	if err != nil {
+		return nil, err
+	}
+
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	return i.overrideRelation(abstractType, &binding{concreteType: concreteType, fields: fields}, newRegistration(opts))
+}
+
+func (i *BaseContainer) OverrideInstance(abstractType reflect.Type, concreteInstance any, opts ...RegistrationOption) func() {
+	restore, err := i.TryOverrideInstance(abstractType, concreteInstance, opts...)
+	must(err)
+
+	return restore
+}
+
+func (i *BaseContainer) TryOverrideInstance(abstractType reflect.Type, concreteInstance any, opts ...RegistrationOption) (func(), error) {
+	concreteType := reflect.TypeOf(concreteInstance)
+
+	if err := checkInstanceRelation(abstractType, concreteType); err != nil {
+		return nil, err
+	}
+
+	r := newRegistration(opts)
+
+	if r.lifetime != Singleton {
+		return nil, fmt.Errorf("%w: %s (lifetime), %s (concrete instance)", ErrLifetimeNotSupported, r.lifetime, typeName(concreteType))
+	}
+
+	b := &binding{concreteType: concreteType}
+
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	restore, err := i.overrideRelation(abstractType, b, r)
+	if err != nil {
+		return nil, err
+	}
+
+	i.instances[b] = concreteInstance
+
+	return restore, nil
+}
+
+// overrideRelation of the abstract type with the binding, replacing the
+// relation registered with the same name, if any, instead of failing with
+// [ErrAlreadyRegistered]. It must be called with the mutex held.
+//
+// Overriding a named contribution of a [Multi] relation replaces it in the
+// ones injected by [DIContainer.InjectAll] as well, while unnamed ones can't
+// be overridden, failing with [ErrNotOverridable].
+//
+// The returned function restores the replaced relation, along with its
+// cached instance, unless the relation was overridden again meanwhile.
+func (i *BaseContainer) overrideRelation(abstractType reflect.Type, b *binding, r registration) (func(), error) {
+	key := bindingKey{abstractType, r.name}
+
+	if r.multi && r.name == "" {
+		return nil, fmt.Errorf("%w: %s (abstract type), multi-bindings must be named to be overridden", ErrNotOverridable, key)
+	}
+
+	previous, overridden := i.relations[key]
+	contributes := overridden && previous.multi
+
+	delete(i.relations, key)
+
+	r.multi = r.multi || contributes
+
+	if err := i.addRelation(abstractType, b, r); err != nil {
+		if overridden {
+			i.relations[key] = previous
+		}
+
+		return nil, err
+	}
+
+	if overridden {
+		i.bindings = i.bindings[:len(i.bindings)-1]
+		i.replaceBinding(previous, b)
+	}
+
+	if contributes {
+		i.multi[abstractType] = i.multi[abstractType][:len(i.multi[abstractType])-1]
+		i.replaceContribution(previous, b)
+	}
+
+	return func() {
+		i.mx.Lock()
+		defer i.mx.Unlock()
+
+		if i.relations[key] != b {
+			return
+		}
+
+		if overridden {
+			i.relations[key] = previous
+			i.replaceBinding(b, previous)
+		} else {
+			delete(i.relations, key)
+			i.replaceBinding(b, nil)
+		}
+
+		if contributes {
+			i.replaceContribution(b, previous)
+		} else if b.multi {
+			i.replaceContribution(b, nil)
+		}
+	}, nil
+}
+
+// replaceBinding in the registration order with the other one, removing it
+// if the other one is nil. It must be called with the mutex held.
+func (i *BaseContainer) replaceBinding(b *binding, other *binding) {
+	for n := range i.bindings {
+		if i.bindings[n] != b {
+			continue
+		}
+
+		if other == nil {
+			i.bindings = append(i.bindings[:n], i.bindings[n+1:]...)
+		} else {
+			i.bindings[n] = other
+		}
+
+		return
+	}
+}
+
+// replaceContribution of the multi-binding with the other one, keeping its
+// order, or removing it if the other one is nil. It must be called with the
+// mutex held.
+func (i *BaseContainer) replaceContribution(b *binding, other *binding) {
+	contributions := i.multi[b.key.abstractType]
+
+	for n := range contributions {
+		if contributions[n] != b {
+			continue
+		}
+
+		if other == nil {
+			i.multi[b.key.abstractType] = append(contributions[:n:n], contributions[n+1:]...)
+		} else {
+			contributions[n] = other
+		}
+
+		return
+	}
+}
@@ -0,0 +1,29 @@
+package goinject
+
+import "reflect"
+
+// Provider of instances of the Abstract type, injected by the DI container as
+// a Provider[Abstract] field or constructor parameter. Each call injects the
+// instance from the container, or scope, it was injected by, building a new
+// one for [Transient] relations.
+//
+//	type OrderHandler struct {
+//		NewUnitOfWork goinject.Provider[UnitOfWork] `inject:""`
+//	}
+//
+//	func (h *OrderHandler) Handle() error {
+//		uow, err := h.NewUnitOfWork()
+//	}
+//
+// The `inject:"name=replica"` option injects a provider of the named relation.
+type Provider[Abstract any] func() (Abstract, error)
+
+func (Provider[Abstract]) handleType() reflect.Type {
+	return reflect.TypeFor[Abstract]()
+}
+
+func (Provider[Abstract]) newHandle(container DIContainer, name string) any {
+	return Provider[Abstract](func() (Abstract, error) {
+		return typed[Abstract](container.TryInjectNamed(reflect.TypeFor[Abstract](), name))
+	})
+}
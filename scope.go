@@ -0,0 +1,53 @@
+package goinject
+
+import "reflect"
+
+// NewScope of the container, like one per HTTP request or queue job.
+//
+// The scope injects the relations of the container, building a single
+// instance per scope for the [Scoped] ones, while [Singleton] instances are
+// still shared with the container. Relations registered into the scope are
+// only visible to it, and shadow the ones of the container.
+//
+//	scope := container.NewScope()
+//	uow := scope.Inject(reflect.TypeFor[UnitOfWork]()).(UnitOfWork)
+func (i *BaseContainer) NewScope() *BaseContainer {
+	scope := NewBaseContainer()
+	scope.enclosing = i
+	scope.strict = i.strict
+
+	return scope
+}
+
+// lookup the binding of the relation in the container or in the ones
+// enclosing its scope, returning nil if there's none. It must be called with
+// the mutex held.
+func (i *BaseContainer) lookup(key bindingKey) *binding {
+	if b, ok := i.relations[key]; ok {
+		return b
+	}
+
+	if i.enclosing == nil {
+		return nil
+	}
+
+	i.enclosing.mx.Lock()
+	defer i.enclosing.mx.Unlock()
+
+	return i.enclosing.lookup(key)
+}
+
+// lookupAll the multi-bindings of the abstract type in the container and in
+// the ones enclosing its scope, starting from the outermost one. It must be
+// called with the mutex held.
+func (i *BaseContainer) lookupAll(abstractType reflect.Type) []*binding {
+	var bindings []*binding
+
+	if i.enclosing != nil {
+		i.enclosing.mx.Lock()
+		bindings = i.enclosing.lookupAll(abstractType)
+		i.enclosing.mx.Unlock()
+	}
+
+	return append(bindings, i.multi[abstractType]...)
+}
@@ -0,0 +1,91 @@
+package goinject
+
+import (
+	"fmt"
+	"reflect"
+)
+
+func (i *BaseContainer) RegisterSelf(concreteInstance any, opts ...RegistrationOption) {
+	must(i.TryRegisterSelf(concreteInstance, opts...))
+}
+
+func (i *BaseContainer) TryRegisterSelf(concreteInstance any, opts ...RegistrationOption) error {
+	concreteType := reflect.TypeOf(concreteInstance)
+
+	if !i.isSelfType(concreteType) {
+		return fmt.Errorf("%w: %s (self-binding)", ErrNotAnInterface, concreteType)
+	}
+
+	r := newRegistration(opts)
+
+	if err := checkSelfRegistration(concreteType, r); err != nil {
+		return err
+	}
+
+	if r.lifetime != Singleton {
+		return fmt.Errorf("%w: %s (lifetime), %s (concrete instance)", ErrLifetimeNotSupported, r.lifetime, typeName(concreteType))
+	}
+
+	b := &binding{concreteType: concreteType}
+
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	if err := i.addRelation(concreteType, b, r); err != nil {
+		return err
+	}
+
+	i.instances[b] = concreteInstance
+
+	return nil
+}
+
+func (i *BaseContainer) RegisterSelfType(concreteType reflect.Type, opts ...RegistrationOption) {
+	must(i.TryRegisterSelfType(concreteType, opts...))
+}
+
+func (i *BaseContainer) TryRegisterSelfType(concreteType reflect.Type, opts ...RegistrationOption) error {
+	if !i.isSelfType(concreteType) {
+		return fmt.Errorf("%w: %s (self-binding)", ErrNotAnInterface, concreteType)
+	}
+
+	if concreteType.Kind() != reflect.Pointer {
+		return fmt.Errorf("%w: %s (self-binding)", ErrNotAnStruct, concreteType)
+	}
+
+	r := newRegistration(opts)
+
+	if err := checkSelfRegistration(concreteType, r); err != nil {
+		return err
+	}
+
+	fields, err := injectFields(concreteType.Elem(), !i.strict)
+	if err != nil {
+		return err
+	}
+
+	i.mx.Lock()
+	defer i.mx.Unlock()
+
+	return i.addRelation(concreteType, &binding{concreteType: concreteType.Elem(), fields: fields}, r)
+}
+
+// isSelfType reports whether the type can be registered as a self-binding,
+// being a struct or *struct type, unless the container is strict.
+func (i *BaseContainer) isSelfType(t reflect.Type) bool {
+	if i.strict || t == nil {
+		return false
+	}
+
+	return isStruct(t)
+}
+
+// checkSelfRegistration validates the registration options of a
+// self-binding, which can't be injected by [DIContainer.InjectAll].
+func checkSelfRegistration(concreteType reflect.Type, r registration) error {
+	if r.multi {
+		return fmt.Errorf("%w: %s (self-binding), multi-bindings must have an interface abstract type", ErrNotAnInterface, concreteType)
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package goinject
+
+import (
+	"fmt"
+	"reflect"
+)
+
+func (i *BaseContainer) Unregister(abstractType reflect.Type) error {
+	return i.UnregisterNamed(abstractType, "")
+}
+
+func (i *BaseContainer) UnregisterNamed(abstractType reflect.Type, name string) error {
+	if abstractType == nil {
+		return ErrNotAnInterface
+	}
+
+	key := bindingKey{abstractType, name}
+
+	i.mx.Lock()
+
+	if i.closed {
+		i.mx.Unlock()
+		return ErrContainerClosed
+	}
+
+	b, ok := i.relations[key]
+	if !ok {
+		i.mx.Unlock()
+		return fmt.Errorf("%w: %s (abstract type)", ErrNoConcreteTypeSupplied, key)
+	}
+
+	delete(i.relations, key)
+	i.replaceBinding(b, nil)
+
+	for n, contribution := range i.multi[abstractType] {
+		if contribution == b {
+			i.multi[abstractType] = append(i.multi[abstractType][:n:n], i.multi[abstractType][n+1:]...)
+			break
+		}
+	}
+
+	instance, cached := i.instances[b]
+	delete(i.instances, b)
+
+	created := false
+
+	for n := range i.created {
+		if i.created[n] == b {
+			i.created = append(i.created[:n:n], i.created[n+1:]...)
+			created = true
+			break
+		}
+	}
+
+	i.mx.Unlock()
+
+	if !cached || !created {
+		return nil
+	}
+
+	return disposeAll([]*binding{b}, []any{instance})
+}
+
+func (i *BaseContainer) Reset() error {
+	i.mx.Lock()
+
+	if i.closed {
+		i.mx.Unlock()
+		return ErrContainerClosed
+	}
+
+	created := i.created
+	instances := make([]any, len(created))
+
+	for n, b := range created {
+		instances[n] = i.instances[b]
+	}
+
+	i.relations = make(map[bindingKey]*binding)
+	i.multi = make(map[reflect.Type][]*binding)
+	i.bindings = nil
+	i.instances = make(map[*binding]any)
+	i.created = nil
+
+	i.mx.Unlock()
+
+	return disposeAll(created, instances)
+}
@@ -0,0 +1,187 @@
+package goinject
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// dependency declared by a binding, either as a constructor parameter or as
+// a tagged field of its concrete type. Deferred dependencies are declared by
+// [Lazy] and [Provider] handles, only injected after the binding is built.
+type dependency struct {
+	key      bindingKey
+	optional bool
+	deferred bool
+}
+
+// newDependency of the type, unwrapping the Abstract type of handles.
+func newDependency(t reflect.Type, name string, optional bool) dependency {
+	if abstractType := handleType(t); abstractType != nil {
+		return dependency{key: bindingKey{abstractType, name}, optional: optional, deferred: true}
+	}
+
+	return dependency{key: bindingKey{t, name}, optional: optional}
+}
+
+// dependencies declared by the binding. Concrete instances and factories
+// don't declare any.
+func (b *binding) dependencies() []dependency {
+	var deps []dependency
+
+	switch {
+	case b.constructor.IsValid():
+		fnType := b.constructor.Type()
+
+		for n := range fnType.NumIn() {
+			deps = append(deps, newDependency(fnType.In(n), "", false))
+		}
+	case b.factory == nil:
+		for _, f := range b.fields {
+			deps = append(deps, newDependency(b.concreteType.Field(f.index).Type, f.name, f.optional))
+		}
+	}
+
+	return deps
+}
+
+// Validate every relation registered into the container, checking that the
+// dependencies declared by their constructor parameters and tagged fields can
+// be injected, without building any instance.
+//
+// Every missing, ambiguous or circular dependency is reported, joined by
+// [errors.Join].
+//
+//	if err := container.Validate(); err != nil {
+//		log.Fatal(err)
+//	}
+func (i *BaseContainer) Validate() error {
+	i.mx.Lock()
+
+	if i.closed {
+		i.mx.Unlock()
+		return ErrContainerClosed
+	}
+
+	bindings := append([]*binding(nil), i.bindings...)
+
+	i.mx.Unlock()
+
+	edges, errs := i.dependencyEdges(bindings)
+	errs = append(errs, findCycles(bindings, edges)...)
+
+	return errors.Join(errs...)
+}
+
+// dependencyEdges from each one of the bindings to the ones injected for their
+// dependencies, except the deferred ones, returning an error for each
+// dependency that can't be injected.
+func (i *BaseContainer) dependencyEdges(bindings []*binding) (map[*binding][]*binding, []error) {
+	var errs []error
+	edges := make(map[*binding][]*binding)
+
+	for _, b := range bindings {
+		for _, dep := range b.dependencies() {
+			resolved, err := i.validateDependency(dep)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%w, required by %s (abstract type)", err, b.key))
+				continue
+			}
+
+			if resolved != nil && !dep.deferred {
+				edges[b] = append(edges[b], resolved)
+			}
+		}
+	}
+
+	return edges, errs
+}
+
+// validateDependency returns the binding injected for the dependency, or nil
+// if it's injected by the parent container or it's optional and missing.
+func (i *BaseContainer) validateDependency(dep dependency) (*binding, error) {
+	i.mx.Lock()
+	b := i.lookup(dep.key)
+	i.mx.Unlock()
+
+	if b != nil {
+		return b, nil
+	}
+
+	if dep.optional || i.hasRelation(dep.key) {
+		return nil, nil
+	}
+
+	if dep.key.name == "" && i.hasAlternatives(dep.key.abstractType) {
+		return nil, fmt.Errorf("%w: %s (abstract type)", ErrAmbiguousDependency, dep.key)
+	}
+
+	return nil, fmt.Errorf("%w: %s (abstract type)", ErrNoConcreteTypeSupplied, dep.key)
+}
+
+// hasAlternatives reports whether the abstract type has named or multi
+// relations registered into the container or the ones enclosing its scope.
+func (i *BaseContainer) hasAlternatives(abstractType reflect.Type) bool {
+	for c := i; c != nil; c = c.enclosing {
+		c.mx.Lock()
+		found := len(c.multi[abstractType]) > 0
+
+		for key := range c.relations {
+			found = found || key.abstractType == abstractType
+		}
+
+		c.mx.Unlock()
+
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// findCycles between the bindings, following the edges to the bindings they
+// depend on, returning an [ErrCircularDependency] error for each one of them.
+func findCycles(bindings []*binding, edges map[*binding][]*binding) []error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	var errs []error
+	var path []*binding
+
+	state := make(map[*binding]int)
+
+	var visit func(b *binding)
+	visit = func(b *binding) {
+		state[b] = visiting
+		path = append(path, b)
+
+		for _, dep := range edges[b] {
+			switch state[dep] {
+			case unvisited:
+				visit(dep)
+			case visiting:
+				for n := range path {
+					if path[n] == dep {
+						errs = append(errs, circularDependency(append(path[n:len(path):len(path)], dep)))
+						break
+					}
+				}
+			}
+		}
+
+		path = path[:len(path)-1]
+		state[b] = visited
+	}
+
+	for _, b := range bindings {
+		if state[b] == unvisited {
+			visit(b)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,93 @@
+package goinject
+
+import (
+	"context"
+	"reflect"
+)
+
+// buildView of a container, injected into the instance being built instead
+// of the container itself, like for its DIContainer fields and its [Lazy] and
+// [Provider] handles.
+//
+// Its injections are made with the context of the build while it isn't
+// finished, so the circular ones fail with [ErrCircularDependency] instead of
+// waiting forever, even when they're made without a context, like from an
+// InitializeDependency method without parameters or from another goroutine.
+// Once the build is finished, it injects like the container itself.
+type buildView struct {
+	*BaseContainer
+	call *buildCall
+}
+
+// view of the container for the call building the context, or the container
+// itself if it isn't building anything.
+func (i *BaseContainer) view(ctx context.Context) DIContainer {
+	call := buildingCall(ctx)
+	if call == nil {
+		return i
+	}
+
+	return &buildView{BaseContainer: i, call: call}
+}
+
+// containerView returns the view of the container injected as the dependency
+// type, or the instance itself if it isn't a container.
+func containerView(ctx context.Context, dependencyType reflect.Type, instance any) any {
+	c, ok := instance.(*BaseContainer)
+	if !ok || dependencyType.Kind() != reflect.Interface {
+		return instance
+	}
+
+	return c.view(ctx)
+}
+
+func (v *buildView) Inject(abstractType reflect.Type) any {
+	instance, err := v.TryInject(abstractType)
+	must(err)
+
+	return instance
+}
+
+func (v *buildView) TryInject(abstractType reflect.Type) (any, error) {
+	return v.TryInjectNamed(abstractType, "")
+}
+
+func (v *buildView) InjectNamed(abstractType reflect.Type, name string) any {
+	instance, err := v.TryInjectNamed(abstractType, name)
+	must(err)
+
+	return instance
+}
+
+func (v *buildView) TryInjectNamed(abstractType reflect.Type, name string) (any, error) {
+	return v.InjectNamedContext(context.Background(), abstractType, name)
+}
+
+func (v *buildView) InjectContext(ctx context.Context, abstractType reflect.Type) (any, error) {
+	return v.InjectNamedContext(ctx, abstractType, "")
+}
+
+func (v *buildView) InjectNamedContext(ctx context.Context, abstractType reflect.Type, name string) (any, error) {
+	return v.BaseContainer.InjectNamedContext(v.context(ctx), abstractType, name)
+}
+
+func (v *buildView) InjectAll(abstractType reflect.Type) []any {
+	instances, err := v.TryInjectAll(abstractType)
+	must(err)
+
+	return instances
+}
+
+func (v *buildView) TryInjectAll(abstractType reflect.Type) ([]any, error) {
+	return v.injectAll(v.context(context.Background()), abstractType)
+}
+
+// context of the build for the injections made with the context, unless it's
+// already building something or the build is finished.
+func (v *buildView) context(ctx context.Context) context.Context {
+	if buildingCall(ctx) != nil || v.call.finished() {
+		return ctx
+	}
+
+	return withBuilding(ctx, v.call)
+}